@@ -17,16 +17,20 @@ options { tokenVocab=CqlLexer; contextSuperClass=CqlContext; }
 
 cqlFilter : booleanExpression EOF;
 booleanExpression                              
-            : LEFTPAREN booleanExpression RIGHTPAREN                # BoolExprParen
+            : LEFTPAREN booleanExpression RIGHTPAREN (IS (NOT)? truthValue)?  # BoolExprParen
             | left=booleanExpression AND right=booleanExpression    # BoolExprAnd
             | left=booleanExpression OR  right=booleanExpression    # BoolExprOr
             | NOT booleanExpression                                 # BoolExprNot
             | booleanTerm                                           # BoolExprTerm
             ;
 //booleanFactor : ( NOT )? booleanPrimary;
-booleanTerm : predicate
-            | booleanLiteral
+booleanTerm : ( predicate
+              | booleanLiteral
+              | propertyName
+              | function
+              ) (IS (NOT)? truthValue)?
             ;
+truthValue : BooleanLiteral | UNKNOWN;
 
 /*============================================================================
 #  CQL supports scalar, spatial, temporal and existence predicates.
@@ -55,17 +59,14 @@ comparisonPredicate : binaryComparisonPredicate # PredicateBinaryComp
 
 binaryComparisonPredicate : left=scalarExpression op=ComparisonOperator right=scalarExpression;
 
-isLikePredicate :  propertyName (NOT)? ( LIKE | ILIKE ) characterLiteral;
+isLikePredicate :  scalarExpression (NOT)? ( LIKE | ILIKE ) scalarExpression;
 
 isBetweenPredicate : scalarExpression (NOT)? BETWEEN
                              scalarExpression AND scalarExpression ;
 
-isInListPredicate : propertyName NOT? IN LEFTPAREN (
-        characterLiteral (COMMA characterLiteral)*
-        | numericLiteral (COMMA numericLiteral)*
-    ) RIGHTPAREN;
+isInListPredicate : scalarExpression NOT? IN LEFTPAREN scalarExpression (COMMA scalarExpression)* RIGHTPAREN;
 
-isNullPredicate : propertyName IS (NOT)? NULL;
+isNullPredicate : scalarExpression IS (NOT)? NULL;
 
 /*============================================================================
 # Scalar expressions
@@ -89,10 +90,16 @@ scalarValue : propertyName      # LiteralName
             | numericLiteral    # LiteralNumeric
             | booleanLiteral    # LiteralBoolean
             | temporalLiteral   # LiteralTemporal
-//                   | function
+            | function          # FunctionValue
              ;
 
-propertyName: Identifier;
+/*
+# Keywords which cannot be mistaken for a property name in context
+# (geometry types, BBOX, EMPTY and UNKNOWN) remain usable as property names
+*/
+propertyName: Identifier
+            | EMPTY | UNKNOWN | ENVELOPE | CIRCULARSTRING | COMPOUNDCURVE | CURVEPOLYGON | MULTICURVE | MULTISURFACE;
+function: Identifier LEFTPAREN (scalarExpression (COMMA scalarExpression)*)? RIGHTPAREN;
 characterLiteral: CharacterStringLiteral;
 numericLiteral: NumericLiteral;
 booleanLiteral: BooleanLiteral;
@@ -109,11 +116,14 @@ distancePredicate :  DistanceOperator LEFTPAREN geomExpression COMMA geomExpress
 
 /*
 # A geometric expression is a property name of a geometry-valued property,
-# a geometric literal (expressed as WKT) or a function that returns a
-# geometric value.
+# a geometric literal (expressed as WKT or EWKT, hex WKB or GeoJSON)
+# or a function that returns a geometric value.
+# Hex WKB made only of digits (and E) lexes as a number, which is read as WKB here.
 */
 geomExpression : propertyName
-               | geomLiteral
+               | EwktSridPrefix? geomLiteral
+               | (WkbHexLiteral | NumericLiteral)
+               | GeoJsonLiteral
                /*| function*/;
 
 /*============================================================================
@@ -127,19 +137,44 @@ geomLiteral: point
              | multiLinestring
              | multiPolygon
              | geometryCollection
+             | circularString
+             | compoundCurve
+             | curvePolygon
+             | multiCurve
+             | multiSurface
              | envelope;
 
-point : POINT pointList;
+point : POINT dimension? (pointList | EMPTY);
 pointList : LEFTPAREN coordinate RIGHTPAREN;
-linestring : LINESTRING coordList;
-polygon : POLYGON polygonDef;
+linestring : LINESTRING dimension? (coordList | EMPTY);
+polygon : POLYGON dimension? (polygonDef | EMPTY);
 polygonDef : LEFTPAREN coordList (COMMA coordList)* RIGHTPAREN;
-multiPoint : MULTIPOINT LEFTPAREN pointList (COMMA pointList)* RIGHTPAREN;
-multiLinestring : MULTILINESTRING LEFTPAREN coordList (COMMA coordList)* RIGHTPAREN;
-multiPolygon : MULTIPOLYGON LEFTPAREN polygonDef (COMMA polygonDef)* RIGHTPAREN;
-geometryCollection : GEOMETRYCOLLECTION LEFTPAREN geomLiteral (COMMA geomLiteral)* RIGHTPAREN;
+multiPoint : MULTIPOINT dimension? (LEFTPAREN pointList (COMMA pointList)* RIGHTPAREN | EMPTY);
+multiLinestring : MULTILINESTRING dimension? (LEFTPAREN coordList (COMMA coordList)* RIGHTPAREN | EMPTY);
+multiPolygon : MULTIPOLYGON dimension? (LEFTPAREN polygonDef (COMMA polygonDef)* RIGHTPAREN | EMPTY);
+geometryCollection : GEOMETRYCOLLECTION dimension? (LEFTPAREN geomLiteral (COMMA geomLiteral)* RIGHTPAREN | EMPTY);
 
-envelope: ENVELOPE LEFTPAREN NumericLiteral COMMA NumericLiteral COMMA NumericLiteral  COMMA NumericLiteral RIGHTPAREN;
+/*
+# Curved geometry types (ISO SQL/MM), as supported by PostGIS
+*/
+circularString : CIRCULARSTRING dimension? (coordList | EMPTY);
+compoundCurve : COMPOUNDCURVE dimension? (LEFTPAREN curveMember (COMMA curveMember)* RIGHTPAREN | EMPTY);
+curveMember : coordList | circularString;
+curvePolygon : CURVEPOLYGON dimension? (LEFTPAREN curveRing (COMMA curveRing)* RIGHTPAREN | EMPTY);
+curveRing : coordList | circularString | compoundCurve;
+//-- the members of a multicurve are the curves allowed as rings of a curve polygon
+multiCurve : MULTICURVE dimension? (LEFTPAREN curveRing (COMMA curveRing)* RIGHTPAREN | EMPTY);
+multiSurface : MULTISURFACE dimension? (LEFTPAREN surfaceMember (COMMA surfaceMember)* RIGHTPAREN | EMPTY);
+surfaceMember : polygonDef | curvePolygon;
+
+envelope: ENVELOPE LEFTPAREN NumericLiteral COMMA NumericLiteral COMMA NumericLiteral  COMMA NumericLiteral
+                           (COMMA NumericLiteral COMMA NumericLiteral)? RIGHTPAREN;
 
 coordList: LEFTPAREN coordinate (COMMA coordinate)* RIGHTPAREN;
-coordinate : NumericLiteral NumericLiteral;
\ No newline at end of file
+coordinate : NumericLiteral NumericLiteral (NumericLiteral NumericLiteral?)?;
+
+/*
+# Coordinate dimension of a geometry: Z, M or ZM.
+# Matched as an identifier so these letters remain usable as property names.
+*/
+dimension : Identifier;
\ No newline at end of file
@@ -67,6 +67,7 @@ BETWEEN : B E T W E E N;
 IS : I S;
 NULL: N U L L;
 IN: I N;
+UNKNOWN: U N K N O W N;
 
 /*============================================================================
 # Definition of ARITHMETIC operators
@@ -78,8 +79,8 @@ ArithmeticOperator : PLUS | MINUS | ASTERISK | SOLIDUS | PERCENT | CARET | CONCA
 # Definition of SPATIAL operators
 #============================================================================*/
 
-SpatialOperator : E Q U A L S | D I S J O I N T | T O U C H E S | W I T H I N | O V E R L A P S
-                | C R O S S E S | I N T E R S E C T S | C O N T A I N S;
+SpatialOperator : (S '_')? ( E Q U A L S | D I S J O I N T | T O U C H E S | W I T H I N | O V E R L A P S
+                | C R O S S E S | I N T E R S E C T S | C O N T A I N S );
 
 /*
 # NOTE: The distance operator BEYOND is not currently included.
@@ -108,7 +109,13 @@ MULTIPOINT: M U L T I P O I N T;
 MULTILINESTRING: M U L T I L I N E S T R I N G;
 MULTIPOLYGON: M U L T I P O L Y G O N;
 GEOMETRYCOLLECTION: G E O M E T R Y C O L L E C T I O N;
-ENVELOPE: E N V E L O P E;
+ENVELOPE: E N V E L O P E | B B O X;
+CIRCULARSTRING: C I R C U L A R S T R I N G;
+COMPOUNDCURVE: C O M P O U N D C U R V E;
+CURVEPOLYGON: C U R V E P O L Y G O N;
+MULTICURVE: M U L T I C U R V E;
+MULTISURFACE: M U L T I S U R F A C E;
+EMPTY: E M P T Y;
 
 /*============================================================================
 # Definition of numeric and text literals
@@ -119,6 +126,18 @@ NumericLiteral : UnsignedNumericLiteral | SignedNumericLiteral;
 
 CharacterStringLiteralStart : QUOTE -> more, mode(STR);// (Character)* QUOTE;
 
+/*============================================================================
+# Definition of geometry encodings other than WKT:
+# EWKT SRID prefix, hex-encoded (E)WKB and GeoJSON geometry objects
+#============================================================================*/
+
+EwktSridPrefix : S R I D EQ DIGIT+ SEMICOLON;
+WkbHexLiteral : ('00' | '01') HexPair HexPair HexPair HexPair HexPair HexPair HexPair HexPair (HexPair)*;
+GeoJsonLiteral : '{' (JsonString | GeoJsonLiteral | ~[{}"])* '}';
+fragment HexPair : HexDigit HexDigit;
+fragment HexDigit : [0-9A-Fa-f];
+fragment JsonString : '"' (~["\\] | '\\' .)* '"';
+
 /*============================================================================
 # Definition of property identifiers
 #============================================================================*/
@@ -179,6 +198,8 @@ Sign : PLUS | MINUS;
 #============================================================================*/
 
 TemporalLiteral : Instant
+    | T I M E S T A M P [ \t\r\n]* '(' [ \t\r\n]* '\'' FullDate 'T' UtcTime '\'' [ \t\r\n]* ')'
+    | D A T E [ \t\r\n]* '(' [ \t\r\n]* '\'' FullDate '\'' [ \t\r\n]* ')'
     // | Interval
     ;
 Instant : FullDate | FullDate 'T' UtcTime | NOW LEFTPAREN RIGHTPAREN;
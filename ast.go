@@ -0,0 +1,565 @@
+package cql2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Node is a node of the syntax tree of a CQL filter
+type Node interface {
+	node()
+}
+
+// Op is an operator or predicate applied to arguments.
+// Names follow CQL2-JSON: "and", "or", "not", "=", "<>", "<", ">", "<=", ">=",
+// "like", "between", "in" (the value followed by the list items), "isNull",
+// the arithmetic operators "+", "-", "*", "/", "%", "^",
+// and the spatial operators "s_intersects", "s_within" etc.
+// Extensions supported by this package are "ilike", "||", "dwithin",
+// and the truth tests "isTrue", "isFalse" and "isUnknown".
+// "and" and "or" take two or more arguments.
+type Op struct {
+	Name string
+	Args []Node
+}
+
+// Call is a function call. Function names are case-insensitive, and are kept in lower case.
+type Call struct {
+	Name string
+	Args []Node
+}
+
+// Property is a reference to a property of a feature
+type Property struct {
+	Name string
+}
+
+// StringLit is a character string literal
+type StringLit struct {
+	Value string
+}
+
+// NumberLit is a numeric literal.
+// Text is the canonical text of the literal, which keeps the exact value of numbers
+// that a float64 cannot represent; it is empty for numbers built from a float64.
+type NumberLit struct {
+	Value float64
+	Text  string
+}
+
+// BoolLit is a boolean literal
+type BoolLit struct {
+	Value bool
+}
+
+// TimestampLit is a timestamp literal, with the value as written (e.g. 2020-01-01T10:00:00Z).
+// Now is set for NOW(), which has no value.
+type TimestampLit struct {
+	Value string
+	Now   bool
+}
+
+// DateLit is a date literal, e.g. 2020-01-01
+type DateLit struct {
+	Value string
+}
+
+// Envelope is a bounding box literal, with bounds (xmin, ymin, xmax, ymax)
+// or (xmin, ymin, zmin, xmax, ymax, zmax).
+// SRID is the SRID given in the literal, or 0 for the filter SRID.
+type Envelope struct {
+	Bounds []float64
+	SRID   int
+}
+
+// Geometry is a geometry literal.
+// Type is a GeoJSON geometry type ("Point", "LineString", "Polygon", "MultiPoint",
+// "MultiLineString", "MultiPolygon", "GeometryCollection"),
+// or a curved type ("CircularString", "CompoundCurve", "CurvePolygon", "MultiCurve", "MultiSurface").
+// Points, lines and circular strings have Coords; the other types consist of Parts
+// (the rings of a polygon are parts of type "LineString" or a curve type).
+// A geometry without coordinates or parts is EMPTY.
+// Dim is the declared coordinate dimension ("", "Z", "M" or "ZM"),
+// and SRID the SRID given in the literal, or 0 for the filter SRID.
+type Geometry struct {
+	Type   string
+	Dim    string
+	SRID   int
+	Coords [][]float64
+	Parts  []*Geometry
+}
+
+func (*Op) node()           {}
+func (*Call) node()         {}
+func (*Property) node()     {}
+func (*StringLit) node()    {}
+func (*NumberLit) node()    {}
+func (*BoolLit) node()      {}
+func (*TimestampLit) node() {}
+func (*DateLit) node()      {}
+func (*Envelope) node()     {}
+func (*Geometry) node()     {}
+
+// Parse parses CQL text into a syntax tree.
+// Operators are nested according to their precedence:
+// NOT binds more tightly than AND, and AND more tightly than OR;
+// ^ binds more tightly than * / %, which bind more tightly than + -, and then ||.
+// An empty filter returns nil.
+// Functions are checked as TranspileToSQL does: only functions declared with WithFunction
+// are accepted besides CASEI and ACCENTI, and other options are ignored.
+func Parse(cqlStr string, opts ...Option) (Node, error) {
+	if len(cqlStr) < 1 {
+		return nil, nil
+	}
+	tree, err := parseCQL(cqlStr)
+	if err != nil {
+		return nil, err
+	}
+	b := &astBuilder{functions: functionsOf(opts)}
+	node := b.boolExpr(tree.BooleanExpression())
+	if b.err != nil {
+		return nil, b.err
+	}
+	return node, nil
+}
+
+type astBuilder struct {
+	// functions which can be called in the filter
+	functions map[string]Function
+	// first error found while building the tree
+	err error
+}
+
+func (b *astBuilder) setError(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+func (b *astBuilder) geometryError(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	start := ctx.GetStart()
+	b.setError(&GeometryError{
+		Line:   start.GetLine(),
+		Column: start.GetColumn(),
+		Msg:    fmt.Sprintf(format, args...),
+	})
+}
+
+// exprItem is an operand or an operator of an expression flattened in text order
+type exprItem struct {
+	op   string
+	node Node
+}
+
+// precedence levels of binary operators, from the loosest to the tightest
+var boolLevels = [][]string{{"or"}, {"and"}}
+var arithmeticLevels = [][]string{{"||"}, {"+", "-"}, {"*", "/", "%"}, {"^"}}
+
+// itemParser nests the operators of a flattened expression by precedence.
+// All binary operators are left-associative.
+type itemParser struct {
+	items  []exprItem
+	pos    int
+	levels [][]string
+	// prefix operator, if any
+	prefix string
+}
+
+func (p *itemParser) parse(level int) Node {
+	if level == len(p.levels) {
+		return p.parseUnary()
+	}
+	left := p.parse(level + 1)
+	for p.pos < len(p.items) && contains(p.levels[level], p.items[p.pos].op) {
+		op := p.items[p.pos].op
+		p.pos++
+		right := p.parse(level + 1)
+		if prev, ok := left.(*Op); ok && prev.Name == op && (op == "and" || op == "or") {
+			prev.Args = append(prev.Args, right)
+		} else {
+			left = &Op{Name: op, Args: []Node{left, right}}
+		}
+	}
+	return left
+}
+
+func (p *itemParser) parseUnary() Node {
+	item := p.items[p.pos]
+	p.pos++
+	if p.prefix != "" && item.op == p.prefix {
+		return &Op{Name: p.prefix, Args: []Node{p.parseUnary()}}
+	}
+	return item.node
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *astBuilder) boolExpr(ctx IBooleanExpressionContext) Node {
+	var items []exprItem
+	b.flattenBool(ctx, &items)
+	p := &itemParser{items: items, levels: boolLevels, prefix: "not"}
+	return p.parse(0)
+}
+
+func (b *astBuilder) flattenBool(ctx IBooleanExpressionContext, items *[]exprItem) {
+	switch expr := ctx.(type) {
+	case *BoolExprAndContext:
+		b.flattenBool(expr.GetLeft(), items)
+		*items = append(*items, exprItem{op: "and"})
+		b.flattenBool(expr.GetRight(), items)
+	case *BoolExprOrContext:
+		b.flattenBool(expr.GetLeft(), items)
+		*items = append(*items, exprItem{op: "or"})
+		b.flattenBool(expr.GetRight(), items)
+	case *BoolExprNotContext:
+		*items = append(*items, exprItem{op: "not"})
+		b.flattenBool(expr.BooleanExpression(), items)
+	case *BoolExprParenContext:
+		*items = append(*items, exprItem{node: truthTest(expr, b.boolExpr(expr.BooleanExpression()))})
+	case *BoolExprTermContext:
+		*items = append(*items, exprItem{node: b.term(expr.BooleanTerm())})
+	}
+}
+
+func negated(ctx interface{ NOT() antlr.TerminalNode }, node Node) Node {
+	if ctx.NOT() != nil {
+		return &Op{Name: "not", Args: []Node{node}}
+	}
+	return node
+}
+
+func (b *astBuilder) term(ctx IBooleanTermContext) Node {
+	var node Node
+	switch {
+	case ctx.BooleanLiteral() != nil:
+		node = &BoolLit{Value: strings.EqualFold(getText(ctx.BooleanLiteral()), "true")}
+	case ctx.PropertyName() != nil:
+		node = propertyNode(ctx.PropertyName())
+	case ctx.Function() != nil:
+		node = b.call(ctx.Function())
+	default:
+		node = b.predicate(ctx.Predicate())
+	}
+	return truthTest(ctx, node)
+}
+
+// truthTest returns the node testing the truth value of a boolean node, if the expression has one
+func truthTest(ctx interface {
+	NOT() antlr.TerminalNode
+	TruthValue() ITruthValueContext
+}, node Node) Node {
+	if ctx.TruthValue() == nil {
+		return node
+	}
+	var name string
+	switch strings.ToUpper(getText(ctx.TruthValue())) {
+	case "TRUE":
+		name = "isTrue"
+	case "FALSE":
+		name = "isFalse"
+	default:
+		name = "isUnknown"
+	}
+	return negated(ctx, &Op{Name: name, Args: []Node{node}})
+}
+
+func (b *astBuilder) predicate(ctx IPredicateContext) Node {
+	if spatial := ctx.SpatialPredicate(); spatial != nil {
+		name := "s_" + strings.TrimPrefix(strings.ToLower(spatial.SpatialOperator().GetText()), "s_")
+		return &Op{Name: name, Args: []Node{b.geomExpr(spatial.GeomExpression(0)), b.geomExpr(spatial.GeomExpression(1))}}
+	}
+	if dist := ctx.DistancePredicate(); dist != nil {
+		return &Op{Name: "dwithin", Args: []Node{
+			b.geomExpr(dist.GeomExpression(0)),
+			b.geomExpr(dist.GeomExpression(1)),
+			b.numberNode(dist.NumericLiteral()),
+		}}
+	}
+	switch pred := ctx.ComparisonPredicate().(type) {
+	case *PredicateBinaryCompContext:
+		comp := pred.BinaryComparisonPredicate().(*BinaryComparisonPredicateContext)
+		return &Op{Name: comp.op.GetText(), Args: []Node{b.scalar(comp.left), b.scalar(comp.right)}}
+	case *PredicateLikeContext:
+		like := pred.IsLikePredicate()
+		name := "like"
+		if like.ILIKE() != nil {
+			name = "ilike"
+		}
+		return negated(like, &Op{Name: name, Args: b.scalars(like.AllScalarExpression())})
+	case *PredicateBetweenContext:
+		between := pred.IsBetweenPredicate()
+		return negated(between, &Op{Name: "between", Args: b.scalars(between.AllScalarExpression())})
+	case *PredicateInContext:
+		in := pred.IsInListPredicate()
+		return negated(in, &Op{Name: "in", Args: b.scalars(in.AllScalarExpression())})
+	case *PredicateIsNullContext:
+		isNull := pred.IsNullPredicate()
+		return negated(isNull, &Op{Name: "isNull", Args: []Node{b.scalar(isNull.ScalarExpression())}})
+	}
+	return nil
+}
+
+func (b *astBuilder) scalars(exprs []IScalarExpressionContext) []Node {
+	nodes := make([]Node, len(exprs))
+	for i, expr := range exprs {
+		nodes[i] = b.scalar(expr)
+	}
+	return nodes
+}
+
+func (b *astBuilder) scalar(ctx IScalarExpressionContext) Node {
+	var items []exprItem
+	b.flattenScalar(ctx, &items)
+	p := &itemParser{items: items, levels: arithmeticLevels}
+	return p.parse(0)
+}
+
+func (b *astBuilder) flattenScalar(ctx IScalarExpressionContext, items *[]exprItem) {
+	switch expr := ctx.(type) {
+	case *ScalarExprContext:
+		b.flattenScalar(expr.left, items)
+		*items = append(*items, exprItem{op: expr.op.GetText()})
+		b.flattenScalar(expr.right, items)
+	case *ScalarParenContext:
+		*items = append(*items, exprItem{node: b.scalar(expr.expr)})
+	case *ScalarValContext:
+		*items = append(*items, exprItem{node: b.value(expr.val)})
+	}
+}
+
+func (b *astBuilder) value(ctx IScalarValueContext) Node {
+	switch val := ctx.(type) {
+	case *LiteralNameContext:
+		return propertyNode(val.PropertyName())
+	case *LiteralStringContext:
+		text := getText(val.CharacterLiteral())
+		return &StringLit{Value: strings.ReplaceAll(text[1:len(text)-1], "''", "'")}
+	case *LiteralNumericContext:
+		return b.numberNode(val.NumericLiteral().NumericLiteral())
+	case *LiteralBooleanContext:
+		return &BoolLit{Value: strings.EqualFold(getText(val.BooleanLiteral()), "true")}
+	case *LiteralTemporalContext:
+		return temporalNode(val.TemporalLiteral())
+	case *FunctionValueContext:
+		return b.call(val.Function())
+	}
+	return nil
+}
+
+func (b *astBuilder) call(ctx IFunctionContext) Node {
+	if _, err := checkFunction(ctx, b.functions); err != nil {
+		b.setError(err)
+	}
+	return &Call{
+		Name: strings.ToLower(getNodeText(ctx.Identifier())),
+		Args: b.scalars(ctx.AllScalarExpression()),
+	}
+}
+
+func propertyNode(ctx IPropertyNameContext) Node {
+	//-- CQL property names can be quoted
+	return &Property{Name: strings.Trim(getText(ctx), "\"")}
+}
+
+func (b *astBuilder) numberNode(node antlr.TerminalNode) Node {
+	text := node.GetText()
+	v, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		b.setError(tokenSyntaxError(node, "number %s is out of range", text))
+	}
+	return &NumberLit{Value: v, Text: formatNumberText(text)}
+}
+
+func temporalNode(ctx ITemporalLiteralContext) Node {
+	val := temporalValue(ctx)
+	switch {
+	case val == "NOW":
+		return &TimestampLit{Now: true}
+	case isDateLiteral(ctx):
+		return &DateLit{Value: val}
+	}
+	return &TimestampLit{Value: val}
+}
+
+func (b *astBuilder) geomExpr(ctx IGeomExpressionContext) Node {
+	switch {
+	case ctx.PropertyName() != nil:
+		return propertyNode(ctx.PropertyName())
+	case wkbLiteral(ctx) != nil:
+		geom, err := decodeWKB(wkbLiteral(ctx).GetText())
+		if err != nil {
+			b.geometryError(ctx, "invalid WKB: %v", err)
+		}
+		return geom
+	case ctx.GeoJsonLiteral() != nil:
+		geom, err := decodeGeoJSON([]byte(ctx.GeoJsonLiteral().GetText()))
+		if err != nil {
+			b.geometryError(ctx, "invalid GeoJSON: %v", err)
+		}
+		return geom
+	}
+	srid := 0
+	if prefix := ctx.EwktSridPrefix(); prefix != nil {
+		srid = ewktSRID(prefix.GetText())
+	}
+	if env, ok := ctx.GeomLiteral().GetChild(0).(*EnvelopeContext); ok {
+		return &Envelope{Bounds: numbers(env.AllNumericLiteral()), SRID: srid}
+	}
+	geom := b.geometry(ctx.GeomLiteral())
+	geom.SRID = srid
+	return geom
+}
+
+// geometry builds a geometry from WKT
+func (b *astBuilder) geometry(ctx IGeomLiteralContext) *Geometry {
+	switch g := ctx.GetChild(0).(type) {
+	case *PointContext:
+		geom := &Geometry{Type: "Point", Dim: b.dimOf(g.Dimension())}
+		if g.PointList() != nil {
+			geom.Coords = [][]float64{numbers(g.PointList().Coordinate().AllNumericLiteral())}
+		}
+		return geom
+	case *LinestringContext:
+		return &Geometry{Type: "LineString", Dim: b.dimOf(g.Dimension()), Coords: coords(g.CoordList())}
+	case *PolygonContext:
+		geom := &Geometry{Type: "Polygon", Dim: b.dimOf(g.Dimension())}
+		if g.PolygonDef() != nil {
+			geom.Parts = rings(g.PolygonDef(), geom.Dim)
+		}
+		return geom
+	case *MultiPointContext:
+		geom := &Geometry{Type: "MultiPoint", Dim: b.dimOf(g.Dimension())}
+		for _, pt := range g.AllPointList() {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "Point", Dim: geom.Dim,
+				Coords: [][]float64{numbers(pt.Coordinate().AllNumericLiteral())}})
+		}
+		return geom
+	case *MultiLinestringContext:
+		geom := &Geometry{Type: "MultiLineString", Dim: b.dimOf(g.Dimension())}
+		for _, line := range g.AllCoordList() {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "LineString", Dim: geom.Dim, Coords: coords(line)})
+		}
+		return geom
+	case *MultiPolygonContext:
+		geom := &Geometry{Type: "MultiPolygon", Dim: b.dimOf(g.Dimension())}
+		for _, poly := range g.AllPolygonDef() {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "Polygon", Dim: geom.Dim, Parts: rings(poly, geom.Dim)})
+		}
+		return geom
+	case *GeometryCollectionContext:
+		geom := &Geometry{Type: "GeometryCollection", Dim: b.dimOf(g.Dimension())}
+		for _, member := range g.AllGeomLiteral() {
+			if _, isEnv := member.GetChild(0).(*EnvelopeContext); isEnv {
+				b.geometryError(member, "ENVELOPE is not allowed in a GEOMETRYCOLLECTION")
+				continue
+			}
+			geom.Parts = append(geom.Parts, b.geometry(member))
+		}
+		return geom
+	case *CircularStringContext:
+		return b.circularString(g)
+	case *CompoundCurveContext:
+		return b.compoundCurve(g)
+	case *CurvePolygonContext:
+		return b.curvePolygon(g)
+	case *MultiCurveContext:
+		geom := &Geometry{Type: "MultiCurve", Dim: b.dimOf(g.Dimension())}
+		for _, member := range g.AllCurveRing() {
+			geom.Parts = append(geom.Parts, b.curve(member, geom.Dim))
+		}
+		return geom
+	case *MultiSurfaceContext:
+		geom := &Geometry{Type: "MultiSurface", Dim: b.dimOf(g.Dimension())}
+		for _, member := range g.AllSurfaceMember() {
+			if member.CurvePolygon() != nil {
+				geom.Parts = append(geom.Parts, b.curvePolygon(member.CurvePolygon()))
+			} else {
+				geom.Parts = append(geom.Parts, &Geometry{Type: "Polygon", Dim: geom.Dim, Parts: rings(member.PolygonDef(), geom.Dim)})
+			}
+		}
+		return geom
+	}
+	return &Geometry{}
+}
+
+func (b *astBuilder) curvePolygon(ctx ICurvePolygonContext) *Geometry {
+	geom := &Geometry{Type: "CurvePolygon", Dim: b.dimOf(ctx.Dimension())}
+	for _, ring := range ctx.AllCurveRing() {
+		geom.Parts = append(geom.Parts, b.curve(ring, geom.Dim))
+	}
+	return geom
+}
+
+// curve builds a ring of a curve polygon or a member of a multicurve
+func (b *astBuilder) curve(ctx ICurveRingContext, dim string) *Geometry {
+	switch {
+	case ctx.CircularString() != nil:
+		return b.circularString(ctx.CircularString())
+	case ctx.CompoundCurve() != nil:
+		return b.compoundCurve(ctx.CompoundCurve())
+	}
+	return &Geometry{Type: "LineString", Dim: dim, Coords: coords(ctx.CoordList())}
+}
+
+func (b *astBuilder) compoundCurve(ctx ICompoundCurveContext) *Geometry {
+	geom := &Geometry{Type: "CompoundCurve", Dim: b.dimOf(ctx.Dimension())}
+	for _, member := range ctx.AllCurveMember() {
+		if member.CircularString() != nil {
+			geom.Parts = append(geom.Parts, b.circularString(member.CircularString()))
+		} else {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "LineString", Dim: geom.Dim, Coords: coords(member.CoordList())})
+		}
+	}
+	return geom
+}
+
+func (b *astBuilder) circularString(ctx ICircularStringContext) *Geometry {
+	return &Geometry{Type: "CircularString", Dim: b.dimOf(ctx.Dimension()), Coords: coords(ctx.CoordList())}
+}
+
+func rings(ctx IPolygonDefContext, dim string) []*Geometry {
+	var parts []*Geometry
+	for _, ring := range ctx.AllCoordList() {
+		parts = append(parts, &Geometry{Type: "LineString", Dim: dim, Coords: coords(ring)})
+	}
+	return parts
+}
+
+func coords(ctx ICoordListContext) [][]float64 {
+	if ctx == nil {
+		return nil
+	}
+	var list [][]float64
+	for _, c := range ctx.AllCoordinate() {
+		list = append(list, numbers(c.AllNumericLiteral()))
+	}
+	return list
+}
+
+func numbers(nodes []antlr.TerminalNode) []float64 {
+	vals := make([]float64, len(nodes))
+	for i, num := range nodes {
+		vals[i], _ = strconv.ParseFloat(num.GetText(), 64)
+	}
+	return vals
+}
+
+func (b *astBuilder) dimOf(ctx IDimensionContext) string {
+	if ctx == nil {
+		return ""
+	}
+	if err := checkDimension(ctx); err != nil {
+		b.setError(err)
+	}
+	return strings.ToUpper(getText(ctx))
+}
@@ -0,0 +1,154 @@
+package cql2
+
+import (
+	"sync"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// The ATN (augmented transition network) of the parser is read from its serialized form in cql_parser.go,
+// since the runtime does not export the targets of transitions.
+// Only what is needed to walk the network is kept.
+
+// kinds of ATN states and transitions, as serialized
+const (
+	atnRuleStop = 7
+
+	atnEpsilon    = 1
+	atnRange      = 2
+	atnRule       = 3
+	atnPredicate  = 4
+	atnAtom       = 5
+	atnAction     = 6
+	atnSet        = 7
+	atnNotSet     = 8
+	atnWildcard   = 9
+	atnPrecedence = 10
+)
+
+type atnState struct {
+	kind        int
+	rule        int
+	transitions []atnTransition
+}
+
+// atnTransition is a transition of the ATN.
+// Tokens are the inclusive ranges of the token types matched by range, atom and set transitions.
+// A rule transition enters the rule at Target, and continues at Follow when the rule ends.
+type atnTransition struct {
+	kind       int
+	target     int
+	tokens     [][2]int
+	rule       int
+	precedence int
+	follow     int
+}
+
+// matches reports whether a transition matches a token type
+func (t atnTransition) matches(tokenType int) bool {
+	for _, r := range t.tokens {
+		if r[0] <= tokenType && tokenType <= r[1] {
+			return t.kind != atnNotSet
+		}
+	}
+	return t.kind == atnNotSet || t.kind == atnWildcard
+}
+
+type parserATN struct {
+	states     []atnState
+	ruleStarts []int
+}
+
+var (
+	cqlATN     *parserATN
+	cqlATNOnce sync.Once
+)
+
+// getParserATN returns the ATN of the CQL parser
+func getParserATN() *parserATN {
+	cqlATNOnce.Do(func() {
+		CQLParserInit()
+		cqlATN = readATN(CQLParserParserStaticData.serializedATN)
+	})
+	return cqlATN
+}
+
+// readATN reads the states, rules and transitions of a serialized parser ATN
+func readATN(data []int32) *parserATN {
+	pos := 0
+	next := func() int {
+		v := data[pos]
+		pos++
+		return int(v)
+	}
+	//-- version, grammar type and maximum token type
+	next()
+	next()
+	next()
+
+	atn := &parserATN{states: make([]atnState, next())}
+	for i := range atn.states {
+		kind := next()
+		if kind == 0 {
+			continue
+		}
+		atn.states[i] = atnState{kind: kind, rule: next()}
+		switch kind {
+		case 3, 4, 5, 12:
+			//-- block end state of block starts, loop back state of loop ends
+			next()
+		}
+	}
+	//-- non-greedy and precedence decisions
+	for n := next(); n > 0; n-- {
+		next()
+	}
+	for n := next(); n > 0; n-- {
+		next()
+	}
+
+	atn.ruleStarts = make([]int, next())
+	for i := range atn.ruleStarts {
+		atn.ruleStarts[i] = next()
+	}
+	//-- modes (lexers only)
+	for n := next(); n > 0; n-- {
+		next()
+	}
+
+	sets := make([][][2]int, next())
+	for i := range sets {
+		n := next()
+		if next() != 0 {
+			sets[i] = append(sets[i], [2]int{antlr.TokenEOF, antlr.TokenEOF})
+		}
+		for ; n > 0; n-- {
+			sets[i] = append(sets[i], [2]int{next(), next()})
+		}
+	}
+
+	for n := next(); n > 0; n-- {
+		src, trg, kind, arg1, arg2, arg3 := next(), next(), next(), next(), next(), next()
+		t := atnTransition{kind: kind, target: trg}
+		switch kind {
+		case atnRange:
+			if arg3 != 0 {
+				arg1 = antlr.TokenEOF
+			}
+			t.tokens = [][2]int{{arg1, arg2}}
+		case atnAtom:
+			if arg3 != 0 {
+				arg1 = antlr.TokenEOF
+			}
+			t.tokens = [][2]int{{arg1, arg1}}
+		case atnSet, atnNotSet:
+			t.tokens = sets[arg1]
+		case atnRule:
+			t.target, t.rule, t.precedence, t.follow = arg1, arg2, arg3, trg
+		case atnPrecedence:
+			t.precedence = arg1
+		}
+		atn.states[src].transitions = append(atn.states[src].transitions, t)
+	}
+	return atn
+}
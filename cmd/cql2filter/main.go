@@ -0,0 +1,158 @@
+// Command cql2filter filters GeoJSON features with a CQL2 filter.
+//
+// Usage:
+//
+//	cql2filter [-haversine] [-geometry NAME] [-strict] FILTER < features.geojson
+//
+// The input is a GeoJSON FeatureCollection, or newline-delimited GeoJSON features.
+// The features matching the filter are written to the output in the same form.
+// Features the filter cannot be evaluated on, such as those with a property value of another type
+// than it is compared with, do not match, unless -strict is given, which stops at the first one.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+func main() {
+	haversine := flag.Bool("haversine", false, "evaluate DWITHIN distances in metres between lon/lat coordinates")
+	geometry := flag.String("geometry", "geometry", "name of the property standing for the feature geometry")
+	strict := flag.Bool("strict", false, "stop at the first feature the filter cannot be evaluated on")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-haversine] [-geometry NAME] [-strict] FILTER < features.geojson\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	//-- syntax errors are reported, the parser debug log is not needed
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	opts := []cql2.CompileOption{cql2.WithGeometryProperty(*geometry)}
+	if *haversine {
+		opts = append(opts, cql2.WithHaversineDistance())
+	}
+	filter, err := cql2.CompileText(flag.Arg(0), opts...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if !*strict {
+		filter = lenient(filter)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	err = filterFeatures(os.Stdin, out, filter)
+	if flushErr := out.Flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// lenient returns a filter which does not match the features a filter cannot be evaluated on
+func lenient(filter func(cql2.Feature) (bool, error)) func(cql2.Feature) (bool, error) {
+	return func(f cql2.Feature) (bool, error) {
+		ok, err := filter(f)
+		return ok && err == nil, nil
+	}
+}
+
+// filterFeatures copies the features matching a filter from a GeoJSON input to the output
+func filterFeatures(r io.Reader, w io.Writer, filter func(cql2.Feature) (bool, error)) error {
+	dec := json.NewDecoder(r)
+	for n := 1; ; n++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var obj struct {
+			Type     string            `json:"type"`
+			Features []json.RawMessage `json:"features"`
+		}
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return fmt.Errorf("item %d: %v", n, err)
+		}
+		switch obj.Type {
+		case "FeatureCollection":
+			if err := writeCollection(w, obj.Features, filter); err != nil {
+				return fmt.Errorf("item %d: %v", n, err)
+			}
+		case "Feature":
+			//-- newline-delimited features are written one per line
+			ok, err := matches(raw, filter)
+			if err != nil {
+				return fmt.Errorf("feature %d: %v", n, err)
+			}
+			if ok {
+				if err := writeFeature(w, raw); err != nil {
+					return err
+				}
+				if _, err := io.WriteString(w, "\n"); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("item %d: %q is not a Feature or FeatureCollection", n, obj.Type)
+		}
+	}
+}
+
+// writeCollection writes the features of a collection matching a filter.
+// Nothing is written if a feature cannot be filtered, so that the output is never truncated JSON.
+func writeCollection(w io.Writer, features []json.RawMessage, filter func(cql2.Feature) (bool, error)) error {
+	var buf bytes.Buffer
+	buf.WriteString(`{"type":"FeatureCollection","features":[`)
+	first := true
+	for i, raw := range features {
+		ok, err := matches(raw, filter)
+		if err != nil {
+			return fmt.Errorf("feature %d: %v", i+1, err)
+		}
+		if !ok {
+			continue
+		}
+		if !first {
+			buf.WriteString(",")
+		}
+		first = false
+		if err := writeFeature(&buf, raw); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("]}\n")
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func matches(raw json.RawMessage, filter func(cql2.Feature) (bool, error)) (bool, error) {
+	f, err := cql2.UnmarshalFeature(raw)
+	if err != nil {
+		return false, err
+	}
+	return filter(f)
+}
+
+// writeFeature writes a feature as it was read, on a single line
+func writeFeature(w io.Writer, raw json.RawMessage) error {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
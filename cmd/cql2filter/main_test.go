@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+const collection = `{
+	"type": "FeatureCollection",
+	"features": [
+		{"type": "Feature", "id": 1, "properties": {"name": "Oslo", "pop": 700000}, "geometry": {"type": "Point", "coordinates": [10.75, 59.91]}},
+		{"type": "Feature", "id": 2, "properties": {"name": "Bergen", "pop": 285000}, "geometry": {"type": "Point", "coordinates": [5.32, 60.39]}},
+		{"type": "Feature", "id": 3, "properties": {"name": "Nowhere", "pop": null}, "geometry": null}
+	]
+}`
+
+const ndjson = `{"type": "Feature", "id": 1, "properties": {"name": "Oslo", "pop": 700000}, "geometry": {"type": "Point", "coordinates": [10.75, 59.91]}}
+{"type": "Feature", "id": 2, "properties": {"name": "Bergen", "pop": 285000}, "geometry": {"type": "Point", "coordinates": [5.32, 60.39]}}
+`
+
+var _ = Describe("cql2filter", func() {
+	DescribeTable("filters features",
+		func(input string, cqlStr string, output string) {
+			filter, err := cql2.CompileText(cqlStr)
+			Expect(err).To(BeNil())
+			var out bytes.Buffer
+			Expect(filterFeatures(strings.NewReader(input), &out, filter)).To(Succeed())
+			Expect(out.String()).To(Equal(output))
+		},
+		Entry("collection", collection, "pop > 500000",
+			`{"type":"FeatureCollection","features":[{"type":"Feature","id":1,"properties":{"name":"Oslo","pop":700000},"geometry":{"type":"Point","coordinates":[10.75,59.91]}}]}`+"\n"),
+		Entry("collection with no matches", collection, "name = 'Trondheim'",
+			`{"type":"FeatureCollection","features":[]}`+"\n"),
+		Entry("spatial filter", collection, "intersects(geometry, ENVELOPE(0, 60, 8, 61))",
+			`{"type":"FeatureCollection","features":[{"type":"Feature","id":2,"properties":{"name":"Bergen","pop":285000},"geometry":{"type":"Point","coordinates":[5.32,60.39]}}]}`+"\n"),
+		Entry("features with a geometry", collection, "geometry IS NOT NULL AND pop < 500000",
+			`{"type":"FeatureCollection","features":[{"type":"Feature","id":2,"properties":{"name":"Bergen","pop":285000},"geometry":{"type":"Point","coordinates":[5.32,60.39]}}]}`+"\n"),
+		Entry("features without a geometry", collection, "geometry IS NULL",
+			`{"type":"FeatureCollection","features":[{"type":"Feature","id":3,"properties":{"name":"Nowhere","pop":null},"geometry":null}]}`+"\n"),
+		Entry("newline-delimited", ndjson, "name LIKE 'B%'",
+			`{"type":"Feature","id":2,"properties":{"name":"Bergen","pop":285000},"geometry":{"type":"Point","coordinates":[5.32,60.39]}}`+"\n"),
+	)
+
+	It("filters on geometry properties", func() {
+		filter, err := cql2.CompileText("intersects(shape, POINT(1 1))")
+		Expect(err).To(BeNil())
+		var out bytes.Buffer
+		Expect(filterFeatures(strings.NewReader(`{"type": "Feature", "properties": {"shape": {"type": "Point", "coordinates": [1, 1]}}, "geometry": null}`), &out, filter)).To(Succeed())
+		Expect(out.String()).To(Equal(`{"type":"Feature","properties":{"shape":{"type":"Point","coordinates":[1,1]}},"geometry":null}` + "\n"))
+	})
+
+	It("writes nothing of a collection which cannot be filtered", func() {
+		filter, err := cql2.CompileText("intersects(shape, POINT(1 1))")
+		Expect(err).To(BeNil())
+		var out bytes.Buffer
+		err = filterFeatures(strings.NewReader(`{"type": "FeatureCollection", "features": [
+			{"type": "Feature", "properties": {"shape": {"type": "Point", "coordinates": [1, 1]}}, "geometry": null},
+			{"type": "Feature", "properties": {"shape": "here"}, "geometry": null}]}`), &out, filter)
+		Expect(err).To(MatchError(ContainSubstring("feature 2")))
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("does not match features which cannot be filtered unless strict", func() {
+		filter, err := cql2.CompileText("pop > 500000")
+		Expect(err).To(BeNil())
+		input := `{"type": "Feature", "id": 1, "properties": {"pop": "many"}, "geometry": null}
+{"type": "Feature", "id": 2, "properties": {"pop": 700000}, "geometry": null}
+`
+		var out bytes.Buffer
+		Expect(filterFeatures(strings.NewReader(input), &out, lenient(filter))).To(Succeed())
+		Expect(out.String()).To(Equal(`{"type":"Feature","id":2,"properties":{"pop":700000},"geometry":null}` + "\n"))
+
+		err = filterFeatures(strings.NewReader(input), &bytes.Buffer{}, filter)
+		Expect(err).To(MatchError(`feature 1: cannot compare string "many" with number 500000`))
+	})
+
+	It("reports invalid input", func() {
+		filter, _ := cql2.CompileText("pop > 1")
+		err := filterFeatures(strings.NewReader(`{"type": "Point", "coordinates": [0, 0]}`), &bytes.Buffer{}, filter)
+		Expect(err).To(MatchError(ContainSubstring("is not a Feature")))
+	})
+})
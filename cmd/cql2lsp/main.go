@@ -0,0 +1,52 @@
+// Command cql2lsp is a language server for CQL2-text filters, communicating over stdio.
+//
+// Usage:
+//
+//	cql2lsp [-queryables queryables.json]
+//
+// Each document holds a filter. The server reports syntax, type and geometry errors
+// as diagnostics, completes keywords, operators, functions and property names,
+// shows the type of a property on hover, and formats filters as canonical CQL2 text.
+// Properties and their types are read from a queryables document (a JSON Schema,
+// as served by OGC API - Features at /collections/{collectionId}/queryables).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+func main() {
+	queryablesPath := flag.String("queryables", "", "queryables document declaring the properties of filters")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-queryables queryables.json]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	//-- stdout carries the protocol, and errors are reported as diagnostics
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	var queryables cql2.Queryables
+	if *queryablesPath != "" {
+		data, err := os.ReadFile(*queryablesPath)
+		if err == nil {
+			queryables, err = cql2.ParseQueryablesSchema(data)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", *queryablesPath, err)
+			os.Exit(1)
+		}
+	}
+	shutdown, err := newServer(queryables, os.Stdout).serve(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if !shutdown {
+		os.Exit(1)
+	}
+}
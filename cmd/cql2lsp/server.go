@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+// server is a language server for documents holding a CQL2-text filter
+type server struct {
+	queryables cql2.Queryables
+	docs       map[string]string
+	w          io.Writer
+	shutdown   bool
+}
+
+func newServer(queryables cql2.Queryables, w io.Writer) *server {
+	return &server{queryables: queryables, docs: make(map[string]string), w: w}
+}
+
+// serve handles messages until the exit notification, and returns whether shutdown was requested before
+func (s *server) serve(r io.Reader) (bool, error) {
+	in := bufio.NewReader(r)
+	for {
+		data, err := readMessage(in)
+		if err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		var req request
+		if err := json.Unmarshal(data, &req); err != nil {
+			return false, err
+		}
+		if req.Method == "exit" {
+			return s.shutdown, nil
+		}
+		if err := s.handle(req); err != nil {
+			return false, err
+		}
+	}
+}
+
+// handle answers a request, or acts on a notification
+func (s *server) handle(req request) error {
+	var result interface{}
+	var err error
+	switch req.Method {
+	case "initialize":
+		result = map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync":           syncFull,
+				"completionProvider":         map[string]interface{}{"triggerCharacters": []string{" ", "("}},
+				"hoverProvider":              true,
+				"documentFormattingProvider": true,
+			},
+			"serverInfo": map[string]string{"name": "cql2lsp"},
+		}
+	case "shutdown":
+		s.shutdown = true
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			s.docs[params.TextDocument.URI] = params.TextDocument.Text
+			return s.publishDiagnostics(params.TextDocument.URI)
+		}
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err = json.Unmarshal(req.Params, &params); err == nil && len(params.ContentChanges) > 0 {
+			//-- the server asks for full text synchronisation
+			s.docs[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+			return s.publishDiagnostics(params.TextDocument.URI)
+		}
+	case "textDocument/didClose":
+		var params documentParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			delete(s.docs, params.TextDocument.URI)
+			return writeMessage(s.w, notification{
+				JSONRPC: "2.0",
+				Method:  "textDocument/publishDiagnostics",
+				Params:  publishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []diagnostic{}},
+			})
+		}
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.completion(s.docs[params.TextDocument.URI], params.Position)
+		}
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			if h := s.hover(s.docs[params.TextDocument.URI], params.Position); h != nil {
+				result = h
+			}
+		}
+	case "textDocument/formatting":
+		var params documentParams
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = formatting(s.docs[params.TextDocument.URI])
+		}
+	default:
+		if req.ID == nil {
+			//-- notifications which are not handled are ignored
+			return nil
+		}
+		return writeMessage(s.w, errorResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method},
+		})
+	}
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		return writeMessage(s.w, errorResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   rpcError{Code: codeInvalidParams, Message: err.Error()},
+		})
+	}
+	return writeMessage(s.w, response{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func (s *server) publishDiagnostics(uri string) error {
+	return writeMessage(s.w, notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params:  publishDiagnosticsParams{URI: uri, Diagnostics: s.diagnostics(s.docs[uri])},
+	})
+}
+
+// diagnostics returns the first error in a filter, located in the text
+func (s *server) diagnostics(text string) []diagnostic {
+	diags := []diagnostic{}
+	if strings.TrimSpace(text) == "" {
+		return diags
+	}
+	_, err := cql2.TranspileToSQL(text, 4326, 4326, cql2.WithQueryables(s.queryables), cql2.WithGeometryValidation(0))
+	if err == nil {
+		return diags
+	}
+	lines := strings.Split(text, "\n")
+	diag := diagnostic{Severity: severityError, Source: "cql2", Message: err.Error()}
+	var syntaxErr *cql2.SyntaxError
+	var typeErr *cql2.TypeError
+	var geomErr *cql2.GeometryError
+	switch {
+	case errors.As(err, &syntaxErr):
+		if syntaxErr.Msg != "" {
+			diag.Message = syntaxErr.Msg
+		}
+		diag.Range = charRange(lines, syntaxErr.Line, syntaxErr.Column)
+	case errors.As(err, &typeErr):
+		diag.Message = typeErr.Msg
+		diag.Range = textRange{Start: offsetPosition(lines, typeErr.Start), End: offsetPosition(lines, typeErr.Stop+1)}
+	case errors.As(err, &geomErr):
+		diag.Message = geomErr.Msg
+		diag.Range = charRange(lines, geomErr.Line, geomErr.Column)
+	}
+	return append(diags, diag)
+}
+
+// charRange returns the range of the character at a parser position (line from 1),
+// or an empty range at the end of the line
+func charRange(lines []string, line int, column int) textRange {
+	if line < 1 || line > len(lines) {
+		return textRange{}
+	}
+	text := lines[line-1]
+	start := position{Line: line - 1, Character: utf16Column(text, column)}
+	end := position{Line: line - 1, Character: utf16Column(text, column+1)}
+	return textRange{Start: start, End: end}
+}
+
+// offsetPosition returns the position of a character offset in the text
+func offsetPosition(lines []string, offset int) position {
+	for i, line := range lines {
+		n := len([]rune(line))
+		if offset <= n {
+			return position{Line: i, Character: utf16Column(line, offset)}
+		}
+		//-- skip the line and its newline
+		offset -= n + 1
+	}
+	last := len(lines) - 1
+	return position{Line: last, Character: utf16Column(lines[last], len([]rune(lines[last])))}
+}
+
+// tokenAt returns the token of a text at a position, if any
+func tokenAt(text string, pos position) (antlr.Token, textRange, bool) {
+	lines := strings.Split(text, "\n")
+	if pos.Line >= len(lines) {
+		return nil, textRange{}, false
+	}
+	column := runeColumn(lines[pos.Line], pos.Character)
+	lexer := cql2.NewCqlLexer(antlr.NewInputStream(text))
+	lexer.RemoveErrorListeners()
+	for _, tok := range lexer.GetAllTokens() {
+		if tok.GetLine() != pos.Line+1 {
+			continue
+		}
+		length := len([]rune(tok.GetText()))
+		if tok.GetColumn() <= column && column <= tok.GetColumn()+length {
+			line := lines[pos.Line]
+			return tok, textRange{
+				Start: position{Line: pos.Line, Character: utf16Column(line, tok.GetColumn())},
+				End:   position{Line: pos.Line, Character: utf16Column(line, tok.GetColumn()+length)},
+			}, true
+		}
+	}
+	return nil, textRange{}, false
+}
+
+// hover describes the property at a position
+func (s *server) hover(text string, pos position) *hover {
+	tok, rng, ok := tokenAt(text, pos)
+	if !ok || tok.GetTokenType() != cql2.CqlLexerIdentifier {
+		return nil
+	}
+	name := strings.Trim(tok.GetText(), "\"")
+	q, ok := s.queryables[name]
+	if !ok {
+		return nil
+	}
+	return &hover{Contents: markupContent{Kind: "markdown", Value: describe(name, q)}, Range: rng}
+}
+
+// describe returns the Markdown description of a property
+func describe(name string, q cql2.Queryable) string {
+	var sb strings.Builder
+	detail := "unknown"
+	if d := q.Detail(); d != "" {
+		detail = "`" + d + "`"
+	}
+	sb.WriteString("**" + name + "**: " + detail)
+	if q.Title != "" {
+		sb.WriteString("\n\n" + q.Title)
+	}
+	if q.Description != "" {
+		sb.WriteString("\n\n" + q.Description)
+	}
+	if len(q.Enum) > 0 {
+		values := make([]string, 0, len(q.Enum))
+		for _, v := range q.Enum {
+			values = append(values, fmt.Sprintf("`%v`", v))
+		}
+		sb.WriteString("\n\nValues: " + strings.Join(values, ", "))
+	}
+	return sb.String()
+}
+
+// completionKinds are the LSP kinds of the kinds of suggestions
+var completionKinds = map[string]int{
+	"property": kindField,
+	"function": kindFunction,
+	"operator": kindOperator,
+}
+
+// completion returns what can be inserted at a position of a filter:
+// the properties, functions, operators and keywords the grammar accepts there.
+// Nothing is offered after a syntax error.
+func (s *server) completion(text string, pos position) []completionItem {
+	c, err := cql2.Complete(text, textOffset(text, pos), s.queryables)
+	if err != nil {
+		return []completionItem{}
+	}
+	items := make([]completionItem, 0, len(c.Suggestions))
+	for _, sug := range c.Suggestions {
+		kind, ok := completionKinds[sug.Kind]
+		if !ok {
+			kind = kindKeyword
+		}
+		item := completionItem{Label: sug.Text, Kind: kind, Detail: sug.Detail}
+		if sug.Kind == "property" {
+			item.Documentation = s.queryables[strings.Trim(sug.Text, "\"")].Description
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// textOffset returns the character offset of a position in a text
+func textOffset(text string, pos position) int {
+	offset := 0
+	for i, line := range strings.Split(text, "\n") {
+		if i == pos.Line {
+			return offset + runeColumn(line, pos.Character)
+		}
+		//-- the line and its newline
+		offset += len([]rune(line)) + 1
+	}
+	return len([]rune(text))
+}
+
+// formatting returns the edit replacing a filter with its canonical text,
+// or no edit if the filter is invalid
+func formatting(text string) []textEdit {
+	formatted, err := cql2.CanonicalText(text)
+	if err != nil || formatted == "" || formatted == text {
+		return []textEdit{}
+	}
+	lines := strings.Split(text, "\n")
+	last := len(lines) - 1
+	end := position{Line: last, Character: utf16Column(lines[last], len([]rune(lines[last])))}
+	return []textEdit{{Range: textRange{End: end}, NewText: formatted}}
+}
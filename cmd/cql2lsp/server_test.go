@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var queryables = cql2.Queryables{
+	"name":    {Type: "string", Title: "Name"},
+	"pop":     {Type: "integer"},
+	"updated": {Type: "string", Format: "date-time"},
+	"geom":    {Format: "geometry-polygon"},
+}
+
+// exchange sends messages to a server, and returns the messages it writes
+func exchange(messages ...string) []map[string]interface{} {
+	var in, out bytes.Buffer
+	for _, msg := range messages {
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+	shutdown, err := newServer(queryables, &out).serve(&in)
+	Expect(err).To(BeNil())
+	Expect(shutdown).To(BeFalse())
+	var replies []map[string]interface{}
+	r := bufio.NewReader(&out)
+	for r.Buffered() > 0 || out.Len() > 0 {
+		data, err := readMessage(r)
+		Expect(err).To(BeNil())
+		var reply map[string]interface{}
+		Expect(json.Unmarshal(data, &reply)).To(Succeed())
+		replies = append(replies, reply)
+	}
+	return replies
+}
+
+func open(text string) string {
+	data, _ := json.Marshal(text)
+	return `{"jsonrpc": "2.0", "method": "textDocument/didOpen", "params": {"textDocument": {"uri": "file:///f.cql", "languageId": "cql2", "version": 1, "text": ` + string(data) + `}}}`
+}
+
+func at(method string, line int, character int) string {
+	return fmt.Sprintf(`{"jsonrpc": "2.0", "id": 2, "method": %q, "params": {"textDocument": {"uri": "file:///f.cql"}, "position": {"line": %d, "character": %d}}}`,
+		method, line, character)
+}
+
+func toJSON(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+var _ = Describe("cql2lsp", func() {
+	It("initializes", func() {
+		replies := exchange(`{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": {"capabilities": {}}}`)
+		Expect(replies).To(HaveLen(1))
+		Expect(replies[0]["id"]).To(Equal(1.0))
+		Expect(toJSON(replies[0]["result"])).To(ContainSubstring(`"hoverProvider":true`))
+	})
+
+	DescribeTable("reports diagnostics",
+		func(text string, expected string) {
+			replies := exchange(open(text))
+			Expect(replies).To(HaveLen(1))
+			Expect(replies[0]["method"]).To(Equal("textDocument/publishDiagnostics"))
+			Expect(toJSON(replies[0]["params"].(map[string]interface{})["diagnostics"])).To(MatchJSON(expected))
+		},
+		Entry("valid filter", "name = 'Oslo'", `[]`),
+		Entry("syntax error", "name = 'Oslo' AND\npop >", `[{
+			"range": {"start": {"line": 1, "character": 5}, "end": {"line": 1, "character": 5}},
+			"severity": 1, "source": "cql2",
+			"message": "mismatched input '<EOF>' expecting {BooleanLiteral, UNKNOWN, ENVELOPE, CIRCULARSTRING, COMPOUNDCURVE, CURVEPOLYGON, MULTICURVE, MULTISURFACE, EMPTY, NumericLiteral, Identifier, '(', TemporalLiteral, CharacterStringLiteral}"
+		}]`),
+		Entry("type error", "pop LIKE 'x%'", `[{
+			"range": {"start": {"line": 0, "character": 0}, "end": {"line": 0, "character": 3}},
+			"severity": 1, "source": "cql2",
+			"message": "property pop is number, LIKE requires string operands"
+		}]`),
+		Entry("geometry error", "intersects(geom, POLYGON((0 0, 1 0, 1 1)))", `[{
+			"range": {"start": {"line": 0, "character": 25}, "end": {"line": 0, "character": 26}},
+			"severity": 1, "source": "cql2",
+			"message": "ring has 3 point(s), at least 4 are required"
+		}]`),
+	)
+
+	It("shows the type of a property", func() {
+		replies := exchange(open("name = 'x' AND updated > TIMESTAMP('2020-01-01T00:00:00Z')"), at("textDocument/hover", 0, 17))
+		Expect(replies).To(HaveLen(2))
+		Expect(replies[1]["result"]).To(Equal(map[string]interface{}{
+			"contents": map[string]interface{}{"kind": "markdown", "value": "**updated**: `string (date-time)`"},
+			"range": map[string]interface{}{
+				"start": map[string]interface{}{"line": 0.0, "character": 15.0},
+				"end":   map[string]interface{}{"line": 0.0, "character": 22.0},
+			},
+		}))
+
+		replies = exchange(open("name = 'x'"), at("textDocument/hover", 0, 8))
+		Expect(replies[1]["result"]).To(BeNil())
+	})
+
+	DescribeTable("completes what the grammar accepts at the position",
+		func(text string, line int, character int, included []string, excluded []string) {
+			replies := exchange(open(text), at("textDocument/completion", line, character))
+			result := toJSON(replies[1]["result"])
+			for _, item := range included {
+				Expect(result).To(ContainSubstring(item))
+			}
+			for _, item := range excluded {
+				Expect(result).ToNot(ContainSubstring(item))
+			}
+		},
+		Entry("predicates", "name = 'x' AND ", 0, 15,
+			[]string{`{"detail":"string","kind":5,"label":"name"}`, `{"kind":14,"label":"NOT"}`, `{"kind":24,"label":"S_INTERSECTS"}`,
+				`{"detail":"string","kind":3,"label":"CASEI"}`},
+			[]string{`"label":"geom"`, `"label":"BETWEEN"`, `"label":"TIMESTAMP"`}),
+		Entry("operators", "name = 'x' AND\npop ", 1, 4,
+			[]string{`{"kind":24,"label":"\u003c\u003e"}`, `{"kind":14,"label":"BETWEEN"}`},
+			[]string{`"label":"name"`}),
+		Entry("geometry properties", "s_intersects(", 0, 13,
+			[]string{`{"detail":"geometry-polygon","kind":5,"label":"geom"}`, `{"kind":14,"label":"ENVELOPE"}`},
+			[]string{`"label":"name"`}),
+		Entry("prefix", "name = 'x' AND po", 0, 17,
+			[]string{`{"detail":"integer","kind":5,"label":"pop"}`},
+			[]string{`"label":"name"`}),
+		Entry("after a syntax error", "name = = 'x' AND ", 0, 17, []string{`[]`}, nil),
+	)
+
+	It("formats filters", func() {
+		replies := exchange(open("name='x' and (pop>5)\n"), `{"jsonrpc": "2.0", "id": 3, "method": "textDocument/formatting", "params": {"textDocument": {"uri": "file:///f.cql"}, "options": {"tabSize": 2, "insertSpaces": true}}}`)
+		Expect(toJSON(replies[1]["result"])).To(MatchJSON(`[{
+			"range": {"start": {"line": 0, "character": 0}, "end": {"line": 1, "character": 0}},
+			"newText": "name = 'x' AND pop > 5"
+		}]`))
+	})
+
+	It("rejects unknown requests", func() {
+		replies := exchange(`{"jsonrpc": "2.0", "id": 4, "method": "workspace/symbol", "params": {}}`, `{"jsonrpc": "2.0", "method": "$/cancelRequest", "params": {"id": 1}}`)
+		Expect(replies).To(HaveLen(1))
+		Expect(replies[0]["error"]).To(HaveKeyWithValue("code", -32601.0))
+	})
+
+	It("exits after shutdown", func() {
+		var in, out bytes.Buffer
+		for _, msg := range []string{`{"jsonrpc": "2.0", "id": 1, "method": "shutdown"}`, `{"jsonrpc": "2.0", "method": "exit"}`} {
+			fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+		}
+		shutdown, err := newServer(nil, &out).serve(&in)
+		Expect(err).To(BeNil())
+		Expect(shutdown).To(BeTrue())
+		Expect(out.String()).To(HaveSuffix(`{"jsonrpc":"2.0","id":1,"result":null}`))
+	})
+})
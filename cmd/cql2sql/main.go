@@ -0,0 +1,239 @@
+// Command cql2sql converts a CQL2 filter to an SQL WHERE clause.
+//
+// Usage:
+//
+//	cql2sql [flags] [FILTER]
+//
+// The filter is CQL2-text or CQL2-JSON, given as the argument or on the standard input.
+// The SQL is written to the output; with -params, the SQL and its parameters are written as JSON.
+// Errors are written as JSON, with the position of the error in the CQL text when it is known.
+//
+// With -i, filters are read line by line, and the tokens, parse tree, syntax tree and SQL
+// of each are shown, with errors marked at their position.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+// config holds the command-line options
+type config struct {
+	filterSRID int
+	sourceSRID int
+	dialect    string
+	params     bool
+	mapping    string
+}
+
+// jsonError is the JSON output for an error
+type jsonError struct {
+	Error  string `json:"error"`
+	Line   int    `json:"line,omitempty"`
+	Column *int   `json:"column,omitempty"`
+	Start  *int   `json:"start,omitempty"`
+	Stop   *int   `json:"stop,omitempty"`
+	// Text is the CQL text the position refers to, for a CQL2-JSON filter
+	Text string `json:"text,omitempty"`
+}
+
+// jsonResult is the JSON output of parameterized SQL
+type jsonResult struct {
+	SQL    string        `json:"sql"`
+	Params []interface{} `json:"params"`
+}
+
+func main() {
+	var cfg config
+	flag.IntVar(&cfg.filterSRID, "filter-srid", 4326, "SRID of the geometry literals in the filter")
+	flag.IntVar(&cfg.sourceSRID, "source-srid", 4326, "SRID of the geometry columns")
+	flag.StringVar(&cfg.dialect, "dialect", "postgis", "SQL dialect (only postgis is supported)")
+	flag.BoolVar(&cfg.params, "params", false, "pass string and temporal literals as parameters, and write the SQL and parameters as JSON")
+	flag.StringVar(&cfg.mapping, "mapping", "", "JSON file mapping property names to column names or queryables")
+	interactive := flag.Bool("i", false, "read filters line by line, and show their tokens, parse tree, syntax tree and SQL")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [FILTER]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	//-- syntax errors are reported, the parser debug log is not needed
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	if *interactive {
+		if err := repl(cfg, os.Stdin, os.Stdout, isTerminal(os.Stdout)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var input string
+	if flag.NArg() == 1 {
+		input = flag.Arg(0)
+	} else {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		input = string(data)
+	}
+	if err := run(cfg, input, os.Stdout); err != nil {
+		os.Exit(1)
+	}
+}
+
+// run converts a filter and writes the SQL, or the error, to the output
+func run(cfg config, input string, w io.Writer) error {
+	out, err := convert(cfg, input)
+	if err != nil {
+		var text string
+		if isJSON(input) {
+			text, _ = textOf(input)
+		}
+		out = errorJSON(err, text)
+	}
+	if _, writeErr := io.WriteString(w, out+"\n"); writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
+// options returns the transpiler options, and the queryables of the mapping file
+func options(cfg config) ([]cql2.Option, cql2.Queryables, error) {
+	if cfg.dialect != "postgis" && cfg.dialect != "postgresql" {
+		return nil, nil, fmt.Errorf("unsupported dialect %q", cfg.dialect)
+	}
+	if cfg.mapping == "" {
+		return nil, nil, nil
+	}
+	queryables, err := readMapping(cfg.mapping)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []cql2.Option{cql2.WithQueryables(queryables)}, queryables, nil
+}
+
+// convert returns the output for a filter
+func convert(cfg config, input string) (string, error) {
+	opts, _, err := options(cfg)
+	if err != nil {
+		return "", err
+	}
+	cqlStr, err := textOf(input)
+	if err != nil {
+		return "", err
+	}
+	return transpile(cfg, cqlStr, opts)
+}
+
+// transpile returns the SQL for CQL text, or with -params the JSON of the SQL and its parameters
+func transpile(cfg config, cqlStr string, opts []cql2.Option) (string, error) {
+	if !cfg.params {
+		return cql2.TranspileToSQL(cqlStr, cfg.filterSRID, cfg.sourceSRID, opts...)
+	}
+	sql, params, err := cql2.TranspileToParameterizedSQL(cqlStr, cfg.filterSRID, cfg.sourceSRID, opts...)
+	if err != nil {
+		return "", err
+	}
+	if params == nil {
+		params = []interface{}{}
+	}
+	return marshal(jsonResult{SQL: sql, Params: params})
+}
+
+// marshal returns the JSON of a value, without escaping the < and > of SQL operators
+func marshal(v interface{}) (string, error) {
+	var sb strings.Builder
+	enc := json.NewEncoder(&sb)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// isJSON reports whether a filter is CQL2-JSON
+func isJSON(input string) bool {
+	return strings.HasPrefix(strings.TrimSpace(input), "{")
+}
+
+// textOf returns the CQL2-text of a filter
+func textOf(input string) (string, error) {
+	if !isJSON(input) {
+		return input, nil
+	}
+	node, err := cql2.ParseJSON([]byte(input))
+	if err != nil {
+		return "", fmt.Errorf("CQL2-JSON error: %v", err)
+	}
+	return cql2.FormatText(node), nil
+}
+
+// readMapping reads a JSON object mapping property names to a column name,
+// or to a queryable (e.g. {"type": "string", "column": "name_en"})
+func readMapping(path string) (cql2.Queryables, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	queryables := make(cql2.Queryables, len(entries))
+	for name, raw := range entries {
+		var q cql2.Queryable
+		if err := json.Unmarshal(raw, &q.Column); err != nil {
+			if err := json.Unmarshal(raw, &q); err != nil {
+				return nil, fmt.Errorf("%s: property %s: %v", path, name, err)
+			}
+		}
+		queryables[name] = q
+	}
+	return queryables, nil
+}
+
+// errorJSON returns the JSON for an error, with its position if it is known
+func errorJSON(err error, text string) string {
+	out := jsonError{Error: err.Error(), Text: text}
+	if line, column, ok := errorPosition(err); ok {
+		out.Line, out.Column = line, &column
+	} else {
+		//-- positions refer to the CQL text, which is not known
+		out.Text = ""
+	}
+	var typeErr *cql2.TypeError
+	if errors.As(err, &typeErr) {
+		out.Start, out.Stop = &typeErr.Start, &typeErr.Stop
+	}
+	data, _ := marshal(out)
+	return data
+}
+
+// errorPosition returns the line and column of an error in the CQL text, if it is known
+func errorPosition(err error) (int, int, bool) {
+	var syntaxErr *cql2.SyntaxError
+	var typeErr *cql2.TypeError
+	var geomErr *cql2.GeometryError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return syntaxErr.Line, syntaxErr.Column, true
+	case errors.As(err, &typeErr):
+		return typeErr.Line, typeErr.Column, true
+	case errors.As(err, &geomErr):
+		return geomErr.Line, geomErr.Column, true
+	}
+	return 0, 0, false
+}
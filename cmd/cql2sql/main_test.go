@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var defaults = config{filterSRID: 4326, sourceSRID: 4326, dialect: "postgis"}
+
+var _ = Describe("cql2sql", func() {
+	DescribeTable("converts filters",
+		func(cfg config, input string, output string) {
+			var out strings.Builder
+			Expect(run(cfg, input, &out)).To(Succeed())
+			Expect(strings.TrimSpace(out.String())).To(Equal(output))
+		},
+		Entry("text", defaults, "name = 'Oslo' AND pop > 5", `"name" = 'Oslo' AND "pop" > 5`),
+		Entry("JSON", defaults, `{"op": "=", "args": [{"property": "name"}, "Oslo"]}`, `"name" = 'Oslo'`),
+		Entry("JSON with a large integer", defaults, `{"op": "=", "args": [{"property": "id"}, 9007199254740993]}`,
+			`"id" = 9007199254740993`),
+		Entry("parameters", config{filterSRID: 4326, sourceSRID: 4326, dialect: "postgis", params: true},
+			"name = 'Oslo' AND pop > 5", `{"sql":"\"name\" = $1 AND \"pop\" > 5","params":["Oslo"]}`),
+		Entry("SRIDs", config{filterSRID: 4326, sourceSRID: 3857, dialect: "postgis"},
+			"intersects(geom, POINT(1 2))", `ST_Intersects("geom",ST_Transform('SRID=4326;POINT(1 2)'::geometry,3857))`),
+	)
+
+	DescribeTable("reports errors",
+		func(cfg config, input string, output string) {
+			var out strings.Builder
+			Expect(run(cfg, input, &out)).ToNot(Succeed())
+			Expect(out.String()).To(MatchJSON(output))
+		},
+		Entry("syntax error", defaults, "name = ",
+			`{"error": "CQL syntax error: \"name =  !!>> \"", "line": 1, "column": 7}`),
+		Entry("unknown function", defaults, "upper(name) = 'OSLO'",
+			`{"error": "CQL syntax error: unknown function \"upper\"", "line": 1, "column": 0}`),
+		Entry("JSON error", defaults, `{"op": "="}`, `{"error": "CQL2-JSON error: $: = needs 2 arguments"}`),
+		Entry("JSON property name", defaults, `{"op": "=", "args": [{"property": "my prop"}, 1]}`,
+			`{"error": "CQL2-JSON error: $.args[0].property: property name \"my prop\" cannot be written in CQL2 text"}`),
+		Entry("JSON timestamp", defaults, `{"op": ">", "args": [{"property": "t"}, {"timestamp": "garbage"}]}`,
+			`{"error": "CQL2-JSON error: $.args[1].timestamp: \"garbage\" is not a valid timestamp"}`),
+		Entry("dialect", config{dialect: "oracle"}, "a = 1", `{"error": "unsupported dialect \"oracle\""}`),
+	)
+
+	It("maps properties to columns", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "mapping.json")
+		Expect(os.WriteFile(path, []byte(`{"name": "name_en", "pop": {"type": "integer", "column": "population"}}`), 0o644)).To(Succeed())
+		cfg := defaults
+		cfg.mapping = path
+		var out strings.Builder
+		Expect(run(cfg, "name = 'Oslo' AND pop > '5'", &out)).To(Succeed())
+		Expect(strings.TrimSpace(out.String())).To(Equal(`"name_en" = 'Oslo' AND "population" > '5'::numeric`))
+
+		out.Reset()
+		Expect(run(cfg, "pop LIKE 'x%'", &out)).ToNot(Succeed())
+		Expect(out.String()).To(ContainSubstring(`"start":0`))
+	})
+})
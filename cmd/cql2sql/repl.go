@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+// ANSI escape sequences of the highlighting
+const (
+	ansiReset   = "\x1b[0m"
+	ansiKeyword = "\x1b[1;34m"
+	ansiLiteral = "\x1b[32m"
+	ansiName    = "\x1b[36m"
+	ansiError   = "\x1b[1;7;31m"
+)
+
+// repl reads filters line by line, and shows how each is lexed, parsed and transpiled
+func repl(cfg config, r io.Reader, w io.Writer, color bool) error {
+	opts, queryables, err := options(cfg)
+	if err != nil {
+		return err
+	}
+	scanner := bufio.NewScanner(r)
+	for {
+		fmt.Fprint(w, "cql> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(w)
+			return scanner.Err()
+		}
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		inspect(cfg, line, opts, queryables, w, color)
+	}
+}
+
+// inspect writes the tokens, parse tree, syntax tree and SQL of a filter
+func inspect(cfg config, input string, opts []cql2.Option, queryables cql2.Queryables, w io.Writer, color bool) {
+	cqlStr, err := textOf(input)
+	if err != nil {
+		fmt.Fprintln(w, err)
+		return
+	}
+	if cqlStr != input {
+		fmt.Fprintln(w, "text:")
+		fmt.Fprintf(w, "  %s\n", cqlStr)
+	}
+
+	parseErrors := &cql2.CqlErrorListener{}
+	lexer := cql2.NewCqlLexer(antlr.NewInputStream(cqlStr))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(parseErrors)
+	tokens := lexer.GetAllTokens()
+	if color {
+		fmt.Fprintln(w, "  "+highlight(cqlStr, tokens, lexer))
+	}
+
+	fmt.Fprintln(w, "tokens:")
+	for _, tok := range tokens {
+		fmt.Fprintf(w, "  %d:%d %s %q\n", tok.GetLine(), tok.GetColumn(), tokenName(lexer, tok), tok.GetText())
+	}
+
+	lexer = cql2.NewCqlLexer(antlr.NewInputStream(cqlStr))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(parseErrors)
+	parser := cql2.NewCQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(parseErrors)
+	tree := parser.CqlFilter()
+	fmt.Fprintln(w, "tree:")
+	fmt.Fprintf(w, "  %s\n", tree.ToStringTree(nil, parser))
+	if err := parseErrors.Err(cqlStr); err != nil {
+		writeError(w, cqlStr, err, color)
+		return
+	}
+
+	node, err := cql2.Parse(cqlStr, opts...)
+	if err != nil {
+		writeError(w, cqlStr, err, color)
+		return
+	}
+	fmt.Fprintln(w, "ast:")
+	writeNode(w, node, queryables, "  ")
+
+	sql, err := transpile(cfg, cqlStr, opts)
+	if err != nil {
+		writeError(w, cqlStr, err, color)
+		return
+	}
+	fmt.Fprintln(w, "sql:")
+	fmt.Fprintf(w, "  %s\n", strings.TrimSpace(sql))
+}
+
+// tokenName returns the symbolic name of the type of a token
+func tokenName(lexer *cql2.CqlLexer, tok antlr.Token) string {
+	if t := tok.GetTokenType(); t > 0 && t < len(lexer.SymbolicNames) {
+		return lexer.SymbolicNames[t]
+	}
+	return fmt.Sprintf("<%d>", tok.GetTokenType())
+}
+
+// highlight returns CQL text with its tokens coloured by kind
+func highlight(cqlStr string, tokens []antlr.Token, lexer *cql2.CqlLexer) string {
+	text := []rune(cqlStr)
+	var sb strings.Builder
+	pos := 0
+	for _, tok := range tokens {
+		start, stop := tok.GetStart(), tok.GetStop()
+		if start < pos || stop >= len(text) {
+			continue
+		}
+		sb.WriteString(string(text[pos:start]))
+		name := tokenName(lexer, tok)
+		switch {
+		case name == "Identifier":
+			sb.WriteString(ansiName)
+		case strings.HasSuffix(name, "Literal"):
+			sb.WriteString(ansiLiteral)
+		case strings.HasSuffix(name, "Operator") || unicode.IsLetter(text[start]):
+			sb.WriteString(ansiKeyword)
+		}
+		sb.WriteString(string(text[start:stop+1]) + ansiReset)
+		pos = stop + 1
+	}
+	sb.WriteString(string(text[pos:]))
+	return sb.String()
+}
+
+// writeError writes an error, with the CQL text marked at the position of the error
+func writeError(w io.Writer, cqlStr string, err error, color bool) {
+	line, column, ok := errorPosition(err)
+	lines := strings.Split(cqlStr, "\n")
+	if !ok || line < 1 || line > len(lines) {
+		fmt.Fprintf(w, "error: %v\n", err)
+		return
+	}
+	text := []rune(lines[line-1])
+	if column > len(text) {
+		column = len(text)
+	}
+	if color {
+		marked := " "
+		if column < len(text) {
+			marked = string(text[column])
+		}
+		rest := ""
+		if column < len(text) {
+			rest = string(text[column+1:])
+		}
+		fmt.Fprintf(w, "  %s%s%s%s\n", string(text[:column]), ansiError, marked, ansiReset+rest)
+	} else {
+		fmt.Fprintf(w, "  %s\n", string(text))
+	}
+	fmt.Fprintf(w, "  %s^\n", strings.Repeat(" ", column))
+	msg := err.Error()
+	var syntaxErr *cql2.SyntaxError
+	if errors.As(err, &syntaxErr) && syntaxErr.Msg != "" {
+		//-- the marker shows where the error is, so give the message of the parser
+		msg = syntaxErr.Msg
+	}
+	fmt.Fprintf(w, "error at line %d, column %d: %s\n", line, column, msg)
+}
+
+// writeNode writes a syntax tree, one node per line, with the declared types of properties
+func writeNode(w io.Writer, node cql2.Node, queryables cql2.Queryables, indent string) {
+	var args []cql2.Node
+	switch n := node.(type) {
+	case *cql2.Op:
+		fmt.Fprintf(w, "%sOp %s\n", indent, n.Name)
+		args = n.Args
+	case *cql2.Call:
+		fmt.Fprintf(w, "%sCall %s\n", indent, n.Name)
+		args = n.Args
+	case *cql2.Property:
+		if q, ok := queryables[n.Name]; ok && (q.Type != "" || q.Format != "") {
+			fmt.Fprintf(w, "%sProperty %s : %s\n", indent, n.Name, q.Detail())
+		} else {
+			fmt.Fprintf(w, "%sProperty %s\n", indent, n.Name)
+		}
+	case *cql2.StringLit:
+		fmt.Fprintf(w, "%sString %q\n", indent, n.Value)
+	case *cql2.NumberLit:
+		if n.Text != "" {
+			fmt.Fprintf(w, "%sNumber %s\n", indent, n.Text)
+		} else {
+			fmt.Fprintf(w, "%sNumber %v\n", indent, n.Value)
+		}
+	case *cql2.BoolLit:
+		fmt.Fprintf(w, "%sBoolean %v\n", indent, n.Value)
+	case *cql2.TimestampLit:
+		if n.Now {
+			fmt.Fprintf(w, "%sTimestamp NOW\n", indent)
+		} else {
+			fmt.Fprintf(w, "%sTimestamp %s\n", indent, n.Value)
+		}
+	case *cql2.DateLit:
+		fmt.Fprintf(w, "%sDate %s\n", indent, n.Value)
+	case *cql2.Envelope:
+		fmt.Fprintf(w, "%sEnvelope %s\n", indent, cql2.FormatText(n))
+	case *cql2.Geometry:
+		fmt.Fprintf(w, "%sGeometry %s %s\n", indent, n.Type, cql2.FormatText(n))
+	}
+	for _, arg := range args {
+		writeNode(w, arg, queryables, indent+"  ")
+	}
+}
+
+// isTerminal reports whether a file is a terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
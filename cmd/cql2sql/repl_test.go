@@ -0,0 +1,46 @@
+package main
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("interactive mode", func() {
+	It("shows each stage of a filter", func() {
+		var out strings.Builder
+		Expect(repl(defaults, strings.NewReader("name = 'Oslo'\n"), &out, false)).To(Succeed())
+		Expect(out.String()).To(Equal(`cql> tokens:
+  1:0 Identifier "name"
+  1:5 ComparisonOperator "="
+  1:7 CharacterStringLiteral "'Oslo'"
+tree:
+  (cqlFilter (booleanExpression (booleanTerm (predicate (comparisonPredicate (binaryComparisonPredicate (scalarExpression (scalarValue (propertyName name))) = (scalarExpression (scalarValue (characterLiteral 'Oslo')))))))) <EOF>)
+ast:
+  Op =
+    Property name
+    String "Oslo"
+sql:
+  "name" = 'Oslo'
+cql> 
+`))
+	})
+
+	DescribeTable("marks errors",
+		func(input string, marker string, msg string) {
+			var out strings.Builder
+			Expect(repl(defaults, strings.NewReader(input+"\n"), &out, false)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("  " + input + "\n" + marker + "\n" + msg))
+		},
+		Entry("syntax error", "a = 1 AND", "           ^", "error at line 1, column 9: mismatched input '<EOF>'"),
+		Entry("unterminated string", "a = 'x", "      ^", "error at line 1, column 4: mismatched input ''x'"),
+	)
+
+	It("colours tokens", func() {
+		var out strings.Builder
+		Expect(repl(defaults, strings.NewReader("a LIKE 'x%'\n"), &out, true)).To(Succeed())
+		Expect(out.String()).To(HavePrefix("cql>   " + ansiName + "a" + ansiReset + " " +
+			ansiKeyword + "LIKE" + ansiReset + " " + ansiLiteral + "'x%'" + ansiReset + "\n"))
+	})
+})
@@ -0,0 +1,201 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+// options are the options of the JavaScript functions, given as an object
+type options struct {
+	// SRIDs of the geometry literals and columns, 4326 by default
+	FilterSRID int `json:"filterSrid"`
+	SourceSRID int `json:"sourceSrid"`
+	// Parameterize passes string and temporal literals as parameters
+	Parameterize bool `json:"parameterize"`
+	// Queryables is a queryables document (a JSON Schema) declaring the properties of filters
+	Queryables json.RawMessage `json:"queryables"`
+	// MaxVertices limits the number of vertices of geometry literals when validating (0 means no limit)
+	MaxVertices int `json:"maxVertices"`
+}
+
+// jsError is the JSON of an error, with its position in the CQL text when it is known.
+// Kind is "syntax", "type", "geometry" or "error".
+type jsError struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	Line    int    `json:"line,omitempty"`
+	Column  *int   `json:"column,omitempty"`
+	Start   *int   `json:"start,omitempty"`
+	Stop    *int   `json:"stop,omitempty"`
+}
+
+type parseResult struct {
+	AST   json.RawMessage `json:"ast,omitempty"`
+	Tree  interface{}     `json:"tree,omitempty"`
+	Text  string          `json:"text,omitempty"`
+	Error *jsError        `json:"error,omitempty"`
+}
+
+type validateResult struct {
+	Valid bool     `json:"valid"`
+	Error *jsError `json:"error,omitempty"`
+}
+
+type transpileResult struct {
+	SQL    string        `json:"sql"`
+	Params []interface{} `json:"params,omitempty"`
+	Error  *jsError      `json:"error,omitempty"`
+}
+
+// parse returns the syntax tree and canonical text of a filter,
+// and its CQL2-JSON unless it uses what CQL2-JSON cannot express
+func parse(cqlStr string, _ options) interface{} {
+	node, err := cql2.Parse(cqlStr)
+	if err != nil {
+		return parseResult{Error: errorOf(err)}
+	}
+	if node == nil {
+		return parseResult{AST: json.RawMessage("null")}
+	}
+	result := parseResult{Tree: treeOf(node), Text: cql2.FormatText(node)}
+	if ast, err := cql2.FormatJSON(node); err == nil {
+		result.AST = ast
+	}
+	return result
+}
+
+// treeOf returns the JSON of a syntax tree node, which has a "node" member naming its type
+// and the fields of the node
+func treeOf(node cql2.Node) map[string]interface{} {
+	switch n := node.(type) {
+	case *cql2.Op:
+		return map[string]interface{}{"node": "op", "name": n.Name, "args": treesOf(n.Args)}
+	case *cql2.Call:
+		return map[string]interface{}{"node": "call", "name": n.Name, "args": treesOf(n.Args)}
+	case *cql2.Property:
+		return map[string]interface{}{"node": "property", "name": n.Name}
+	case *cql2.StringLit:
+		return map[string]interface{}{"node": "string", "value": n.Value}
+	case *cql2.NumberLit:
+		//-- the text keeps the exact value of numbers which a JavaScript number cannot represent
+		text := n.Text
+		if text == "" {
+			text = strconv.FormatFloat(n.Value, 'g', -1, 64)
+		}
+		return map[string]interface{}{"node": "number", "value": n.Value, "text": text}
+	case *cql2.BoolLit:
+		return map[string]interface{}{"node": "boolean", "value": n.Value}
+	case *cql2.TimestampLit:
+		if n.Now {
+			return map[string]interface{}{"node": "timestamp", "now": true}
+		}
+		return map[string]interface{}{"node": "timestamp", "value": n.Value}
+	case *cql2.DateLit:
+		return map[string]interface{}{"node": "date", "value": n.Value}
+	case *cql2.Envelope:
+		return map[string]interface{}{"node": "envelope", "bounds": n.Bounds, "srid": n.SRID}
+	case *cql2.Geometry:
+		return geometryTree(n)
+	}
+	return map[string]interface{}{"node": fmt.Sprintf("%T", node)}
+}
+
+func treesOf(nodes []cql2.Node) []interface{} {
+	trees := make([]interface{}, len(nodes))
+	for i, node := range nodes {
+		trees[i] = treeOf(node)
+	}
+	return trees
+}
+
+// geometryTree returns the JSON of a geometry literal, with either coordinates or parts
+func geometryTree(geom *cql2.Geometry) map[string]interface{} {
+	tree := map[string]interface{}{"node": "geometry", "type": geom.Type, "dim": geom.Dim, "srid": geom.SRID}
+	if geom.Parts != nil {
+		parts := make([]interface{}, len(geom.Parts))
+		for i, part := range geom.Parts {
+			parts[i] = geometryTree(part)
+		}
+		tree["parts"] = parts
+	} else {
+		coords := geom.Coords
+		if coords == nil {
+			coords = [][]float64{}
+		}
+		tree["coordinates"] = coords
+	}
+	return tree
+}
+
+// validate checks the syntax of a filter, the types of its properties and its geometry literals
+func validate(cqlStr string, opts options) interface{} {
+	transpileOpts, err := transpileOptions(opts)
+	if err == nil {
+		transpileOpts = append(transpileOpts, cql2.WithGeometryValidation(opts.MaxVertices))
+		_, err = cql2.TranspileToSQL(cqlStr, opts.FilterSRID, opts.SourceSRID, transpileOpts...)
+	}
+	if err != nil {
+		return validateResult{Error: errorOf(err)}
+	}
+	return validateResult{Valid: true}
+}
+
+// transpile returns the SQL of a filter, with its parameters if they are asked for
+func transpile(cqlStr string, opts options) interface{} {
+	transpileOpts, err := transpileOptions(opts)
+	if err != nil {
+		return transpileResult{Error: errorOf(err)}
+	}
+	if !opts.Parameterize {
+		sql, err := cql2.TranspileToSQL(cqlStr, opts.FilterSRID, opts.SourceSRID, transpileOpts...)
+		if err != nil {
+			return transpileResult{Error: errorOf(err)}
+		}
+		return transpileResult{SQL: sql}
+	}
+	sql, params, err := cql2.TranspileToParameterizedSQL(cqlStr, opts.FilterSRID, opts.SourceSRID, transpileOpts...)
+	if err != nil {
+		return transpileResult{Error: errorOf(err)}
+	}
+	return transpileResult{SQL: sql, Params: params}
+}
+
+// transpileOptions returns the transpiler options
+func transpileOptions(opts options) ([]cql2.Option, error) {
+	if len(opts.Queryables) == 0 || string(opts.Queryables) == "null" {
+		return nil, nil
+	}
+	queryables, err := cql2.ParseQueryablesSchema(opts.Queryables)
+	if err != nil {
+		return nil, fmt.Errorf("queryables: %v", err)
+	}
+	return []cql2.Option{cql2.WithQueryables(queryables)}, nil
+}
+
+// errorOf returns the JSON of an error, with its position if it is known
+func errorOf(err error) *jsError {
+	out := &jsError{Kind: "error", Message: err.Error()}
+	var syntaxErr *cql2.SyntaxError
+	var typeErr *cql2.TypeError
+	var geomErr *cql2.GeometryError
+	switch {
+	case errors.As(err, &syntaxErr):
+		out.Kind, out.Line, out.Column = "syntax", syntaxErr.Line, &syntaxErr.Column
+		if syntaxErr.Msg != "" {
+			out.Message = syntaxErr.Msg
+		}
+	case errors.As(err, &typeErr):
+		out.Kind, out.Line, out.Column = "type", typeErr.Line, &typeErr.Column
+		out.Message, out.Start, out.Stop = typeErr.Msg, &typeErr.Start, &typeErr.Stop
+	case errors.As(err, &geomErr):
+		out.Kind, out.Line, out.Column = "geometry", geomErr.Line, &geomErr.Column
+		out.Message = geomErr.Msg
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+//go:build js && wasm
+
+// Command cql2wasm exposes the CQL2 parser and transpiler to JavaScript, as WebAssembly.
+//
+// Build:
+//
+//	GOOS=js GOARCH=wasm go build -o cql2.wasm ./cmd/cql2wasm
+//
+// and run it with wasm_exec.js from $(go env GOROOT)/lib/wasm (misc/wasm before Go 1.24).
+// The program sets a global cql2 object with the functions
+//
+//	cql2.parse(filter)               // {ast, tree, text} or {error}
+//	cql2.validate(filter, options)   // {valid, error}
+//	cql2.transpile(filter, options)  // {sql, params} or {error}
+//
+// The filter is CQL2-text. parse returns its syntax tree as CQL2-JSON (ast), unless the filter
+// uses what CQL2-JSON cannot express, such as DWITHIN, || or curves, the tree of all the nodes
+// of the filter (tree), and its canonical text.
+// The options object may set filterSrid and sourceSrid (4326 by default), parameterize
+// to pass string and temporal literals as parameters, queryables to a queryables document
+// declaring the properties and their types, and maxVertices to limit geometry literals.
+// Errors are objects with a kind ("syntax", "type", "geometry" or "error"), a message,
+// and the line and column of the error in the filter when they are known.
+//
+// test.js runs the functions with Node.js.
+package main
+
+import (
+	"encoding/json"
+	"syscall/js"
+
+	"github.com/rs/zerolog"
+)
+
+func main() {
+	//-- errors are returned to JavaScript, the parser debug log is not needed
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	js.Global().Set("cql2", js.ValueOf(map[string]interface{}{
+		"parse":     function(parse),
+		"validate":  function(validate),
+		"transpile": function(transpile),
+	}))
+	//-- the functions are called from JavaScript until the program is unloaded
+	select {}
+}
+
+// function returns a JavaScript function of a filter and options,
+// which returns the result of a Go function as an object
+func function(f func(cqlStr string, opts options) interface{}) js.Func {
+	return js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+		var result interface{}
+		opts, err := optionsOf(args)
+		switch {
+		case len(args) == 0 || args[0].Type() != js.TypeString:
+			result = map[string]interface{}{"error": jsError{Kind: "error", Message: "the filter must be a string"}}
+		case err != nil:
+			result = map[string]interface{}{"error": jsError{Kind: "error", Message: "options: " + err.Error()}}
+		default:
+			result = f(args[0].String(), opts)
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			data, _ = json.Marshal(map[string]interface{}{"error": jsError{Kind: "error", Message: err.Error()}})
+		}
+		return js.Global().Get("JSON").Call("parse", string(data))
+	})
+}
+
+// optionsOf returns the options given as the second argument, if any
+func optionsOf(args []js.Value) (options, error) {
+	opts := options{FilterSRID: 4326, SourceSRID: 4326}
+	if len(args) < 2 || args[1].IsUndefined() || args[1].IsNull() {
+		return opts, nil
+	}
+	data := js.Global().Get("JSON").Call("stringify", args[1]).String()
+	err := json.Unmarshal([]byte(data), &opts)
+	return opts, err
+}
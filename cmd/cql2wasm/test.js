@@ -0,0 +1,188 @@
+// Tests of cql2wasm with Node.js, offline:
+//
+//	node cmd/cql2wasm/test.js
+//
+// The WebAssembly module is built with the go command, or read from the file named by $CQL2_WASM.
+"use strict";
+
+const assert = require("node:assert/strict");
+const { execFileSync } = require("node:child_process");
+const fs = require("node:fs");
+const os = require("node:os");
+const path = require("node:path");
+const { after, before, describe, it } = require("node:test");
+
+// loadWasmExec defines the Go class of the wasm_exec.js of the Go installation
+function loadWasmExec() {
+	const goroot = execFileSync("go", ["env", "GOROOT"], { encoding: "utf8" }).trim();
+	for (const dir of ["lib/wasm", "misc/wasm"]) {
+		const file = path.join(goroot, dir, "wasm_exec.js");
+		if (fs.existsSync(file)) {
+			require(file);
+			return;
+		}
+	}
+	throw new Error("wasm_exec.js not found in " + goroot);
+}
+
+// directory of the module built by the tests
+let buildDir;
+
+// build returns the path of the WebAssembly module
+function build() {
+	if (process.env.CQL2_WASM) {
+		return process.env.CQL2_WASM;
+	}
+	buildDir = fs.mkdtempSync(path.join(os.tmpdir(), "cql2wasm-"));
+	const out = path.join(buildDir, "cql2.wasm");
+	execFileSync("go", ["build", "-o", out, "."], {
+		cwd: __dirname,
+		env: { ...process.env, GOOS: "js", GOARCH: "wasm" },
+		stdio: "inherit",
+	});
+	return out;
+}
+
+const queryables = {
+	$schema: "https://json-schema.org/draft/2019-09/schema",
+	type: "object",
+	properties: {
+		name: { type: "string" },
+		pop: { type: "integer" },
+		geom: { format: "geometry-polygon" },
+	},
+};
+
+before(async () => {
+	loadWasmExec();
+	const go = new Go();
+	const { instance } = await WebAssembly.instantiate(fs.readFileSync(build()), go.importObject);
+	//-- the program runs until the functions are set, and then waits for calls
+	go.run(instance);
+	assert.equal(typeof globalThis.cql2, "object");
+});
+
+after(() => {
+	if (buildDir) {
+		fs.rmSync(buildDir, { recursive: true, force: true });
+	}
+});
+
+describe("parse", () => {
+	it("returns the syntax tree as CQL2-JSON", () => {
+		const result = cql2.parse("name = 'x' AND pop > 5");
+		assert.deepEqual(result.ast, {
+			op: "and",
+			args: [
+				{ op: "=", args: [{ property: "name" }, "x"] },
+				{ op: ">", args: [{ property: "pop" }, 5] },
+			],
+		});
+		assert.equal(result.text, "name = 'x' AND pop > 5");
+	});
+
+	it("returns the tree of all the nodes", () => {
+		const result = cql2.parse("name = 'x' AND id > 9007199254740993");
+		assert.deepEqual(result.tree, {
+			node: "op",
+			name: "and",
+			args: [
+				{ node: "op", name: "=", args: [{ node: "property", name: "name" }, { node: "string", value: "x" }] },
+				{
+					node: "op",
+					name: ">",
+					args: [{ node: "property", name: "id" }, { node: "number", value: 9007199254740992, text: "9007199254740993" }],
+				},
+			],
+		});
+	});
+
+	for (const [name, filter, text] of [
+		["distance", "dwithin(geom, POINT(0 0), 10)", "DWITHIN(geom, POINT(0 0), 10)"],
+		["concatenation", "a || 'b' = 'c'", "a || 'b' = 'c'"],
+		["curve", "s_intersects(geom, CIRCULARSTRING(0 0, 1 1, 2 0))", "S_INTERSECTS(geom, CIRCULARSTRING(0 0, 1 1, 2 0))"],
+	]) {
+		it(`returns the tree and text of filters CQL2-JSON cannot express: ${name}`, () => {
+			const result = cql2.parse(filter);
+			assert.equal(result.error, undefined);
+			assert.equal(result.ast, undefined);
+			assert.equal(result.text, text);
+			assert.equal(result.tree.node, "op");
+		});
+	}
+
+	it("returns the tree of geometries", () => {
+		const { tree } = cql2.parse("s_intersects(geom, CIRCULARSTRING(0 0, 1 1, 2 0))");
+		assert.deepEqual(tree.args[1], {
+			node: "geometry",
+			type: "CircularString",
+			dim: "",
+			srid: 0,
+			coordinates: [
+				[0, 0],
+				[1, 1],
+				[2, 0],
+			],
+		});
+	});
+
+	it("returns syntax errors with their position", () => {
+		const { error } = cql2.parse("name = = 'x'");
+		assert.equal(error.kind, "syntax");
+		assert.equal(error.line, 1);
+		assert.equal(error.column, 7);
+		assert.match(error.message, /=/);
+	});
+
+	it("rejects arguments which are not filters", () => {
+		assert.equal(cql2.parse(42).error.message, "the filter must be a string");
+	});
+});
+
+describe("validate", () => {
+	it("accepts valid filters", () => {
+		assert.deepEqual(cql2.validate("S_INTERSECTS(geom, POINT(1 2))", { queryables }), { valid: true });
+	});
+
+	it("reports type errors", () => {
+		const { valid, error } = cql2.validate("pop LIKE 'a%'", { queryables });
+		assert.equal(valid, false);
+		assert.deepEqual(error, {
+			kind: "type",
+			message: "property pop is number, LIKE requires string operands",
+			line: 1,
+			column: 0,
+			start: 0,
+			stop: 2,
+		});
+	});
+
+	it("reports geometry errors", () => {
+		const { error } = cql2.validate("S_WITHIN(geom, POLYGON((0 0, 1 0, 0 0)))");
+		assert.equal(error.kind, "geometry");
+		assert.equal(error.message, "ring has 3 point(s), at least 4 are required");
+	});
+});
+
+describe("transpile", () => {
+	it("returns SQL", () => {
+		assert.equal(cql2.transpile("pop >= 5").sql.trim(), '"pop" >= 5');
+	});
+
+	it("returns parameters", () => {
+		const result = cql2.transpile("name = 'x'", { parameterize: true });
+		assert.equal(result.sql.trim(), '"name" = $1');
+		assert.deepEqual(result.params, ["x"]);
+	});
+
+	it("transforms geometry literals", () => {
+		const result = cql2.transpile("S_INTERSECTS(geom, POINT(1 2))", { sourceSrid: 3857 });
+		assert.match(result.sql, /ST_Transform\('SRID=4326;POINT\(1 2\)'::geometry,3857\)/);
+	});
+
+	it("reports invalid queryables", () => {
+		const { error } = cql2.transpile("pop > 5", { queryables: { properties: [] } });
+		assert.equal(error.kind, "error");
+		assert.match(error.message, /^queryables: /);
+	});
+});
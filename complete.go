@@ -0,0 +1,329 @@
+package cql2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Suggestion is a word or symbol which can be inserted at a caret.
+// Kind is "property", "function", "operator", "keyword", "geometry", "temporal" or "punctuation".
+// Detail is the declared type of a property, or the result type of a function.
+type Suggestion struct {
+	Text   string
+	Kind   string
+	Detail string
+}
+
+// Candidate is a token which the parser accepts at a caret.
+// Token is a CQLParser token type, or antlr.TokenEOF if the filter can end there,
+// and Rules are the parser rules being matched, from cqlFilter to the rule matching the token.
+type Candidate struct {
+	Token int
+	Rules []string
+}
+
+// Completion describes what can follow the text before a caret.
+// Prefix is the partial word before the caret which the suggestions complete,
+// and Start its offset in characters.
+type Completion struct {
+	Prefix      string
+	Start       int
+	Candidates  []Candidate
+	Suggestions []Suggestion
+}
+
+// suggestions of the tokens which are not names
+var tokenSuggestions = map[int][]Suggestion{
+	CQLParserComparisonOperator: {
+		{Text: "=", Kind: "operator"}, {Text: "<>", Kind: "operator"},
+		{Text: "<", Kind: "operator"}, {Text: ">", Kind: "operator"},
+		{Text: "<=", Kind: "operator"}, {Text: ">=", Kind: "operator"},
+	},
+	CQLParserArithmeticOperator: {
+		{Text: "+", Kind: "operator"}, {Text: "-", Kind: "operator"}, {Text: "*", Kind: "operator"},
+		{Text: "/", Kind: "operator"}, {Text: "%", Kind: "operator"}, {Text: "^", Kind: "operator"},
+		{Text: "||", Kind: "operator"},
+	},
+	CQLParserBooleanLiteral: {{Text: "TRUE", Kind: "keyword"}, {Text: "FALSE", Kind: "keyword"}},
+	CQLParserAND:            {{Text: "AND", Kind: "keyword"}},
+	CQLParserOR:             {{Text: "OR", Kind: "keyword"}},
+	CQLParserNOT:            {{Text: "NOT", Kind: "keyword"}},
+	CQLParserLIKE:           {{Text: "LIKE", Kind: "keyword"}},
+	CQLParserILIKE:          {{Text: "ILIKE", Kind: "keyword"}},
+	CQLParserBETWEEN:        {{Text: "BETWEEN", Kind: "keyword"}},
+	CQLParserIS:             {{Text: "IS", Kind: "keyword"}},
+	CQLParserNULL:           {{Text: "NULL", Kind: "keyword"}},
+	CQLParserIN:             {{Text: "IN", Kind: "keyword"}},
+	CQLParserUNKNOWN:        {{Text: "UNKNOWN", Kind: "keyword"}},
+	CQLParserSpatialOperator: {
+		{Text: "S_INTERSECTS", Kind: "operator"}, {Text: "S_DISJOINT", Kind: "operator"},
+		{Text: "S_CONTAINS", Kind: "operator"}, {Text: "S_WITHIN", Kind: "operator"},
+		{Text: "S_TOUCHES", Kind: "operator"}, {Text: "S_CROSSES", Kind: "operator"},
+		{Text: "S_OVERLAPS", Kind: "operator"}, {Text: "S_EQUALS", Kind: "operator"},
+	},
+	CQLParserDistanceOperator:   {{Text: "DWITHIN", Kind: "operator"}},
+	CQLParserPOINT:              {{Text: "POINT", Kind: "geometry"}},
+	CQLParserLINESTRING:         {{Text: "LINESTRING", Kind: "geometry"}},
+	CQLParserPOLYGON:            {{Text: "POLYGON", Kind: "geometry"}},
+	CQLParserMULTIPOINT:         {{Text: "MULTIPOINT", Kind: "geometry"}},
+	CQLParserMULTILINESTRING:    {{Text: "MULTILINESTRING", Kind: "geometry"}},
+	CQLParserMULTIPOLYGON:       {{Text: "MULTIPOLYGON", Kind: "geometry"}},
+	CQLParserGEOMETRYCOLLECTION: {{Text: "GEOMETRYCOLLECTION", Kind: "geometry"}},
+	CQLParserENVELOPE:           {{Text: "BBOX", Kind: "geometry"}, {Text: "ENVELOPE", Kind: "geometry"}},
+	CQLParserCIRCULARSTRING:     {{Text: "CIRCULARSTRING", Kind: "geometry"}},
+	CQLParserCOMPOUNDCURVE:      {{Text: "COMPOUNDCURVE", Kind: "geometry"}},
+	CQLParserCURVEPOLYGON:       {{Text: "CURVEPOLYGON", Kind: "geometry"}},
+	CQLParserMULTICURVE:         {{Text: "MULTICURVE", Kind: "geometry"}},
+	CQLParserMULTISURFACE:       {{Text: "MULTISURFACE", Kind: "geometry"}},
+	CQLParserEMPTY:              {{Text: "EMPTY", Kind: "geometry"}},
+	CQLParserTemporalLiteral: {
+		{Text: "TIMESTAMP()", Kind: "temporal"}, {Text: "DATE()", Kind: "temporal"}, {Text: "NOW()", Kind: "temporal"},
+	},
+	CQLParserLEFTPAREN:  {{Text: "(", Kind: "punctuation"}},
+	CQLParserRIGHTPAREN: {{Text: ")", Kind: "punctuation"}},
+	CQLParserCOMMA:      {{Text: ",", Kind: "punctuation"}},
+}
+
+// suggestions of the coordinate dimensions
+var dimensionSuggestions = []Suggestion{{Text: "Z", Kind: "geometry"}, {Text: "M", Kind: "geometry"}, {Text: "ZM", Kind: "geometry"}}
+
+// order of the kinds of suggestions
+var suggestionKinds = []string{"property", "function", "operator", "keyword", "geometry", "temporal", "punctuation"}
+
+// Complete returns what can follow the text of a filter before a caret,
+// given as an offset in characters.
+// A word ending at the caret is completed from its start.
+// The candidates are found by walking the parser ATN over the tokens before the caret,
+// so all the alternatives of the grammar are followed, whatever the parser would predict.
+// Property names are suggested from the queryables: geometry properties in geometry expressions,
+// and the other properties elsewhere.
+// Functions are those TranspileToSQL accepts with the same options: CASEI, ACCENTI,
+// and the functions declared with WithFunction.
+// A syntax error before the caret is returned as a SyntaxError.
+func Complete(cqlStr string, caret int, queryables Queryables, opts ...Option) (*Completion, error) {
+	text := []rune(cqlStr)
+	if caret < 0 || caret > len(text) {
+		caret = len(text)
+	}
+	c := &Completion{Start: caret}
+	parseErrors := &CqlErrorListener{}
+	lexer := NewCqlLexer(antlr.NewInputStream(string(text[:caret])))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(parseErrors)
+	tokens := lexer.GetAllTokens()
+	//-- a word ending at the caret is replaced by a suggestion
+	if n := len(tokens); n > 0 && tokens[n-1].GetStop()+1 == caret && isWord(tokens[n-1].GetText()) {
+		c.Prefix = tokens[n-1].GetText()
+		c.Start = tokens[n-1].GetStart()
+		tokens = tokens[:n-1]
+	}
+	input := string(text[:c.Start])
+	if parseErrors.errorCount > 0 {
+		return nil, parseErrors.Err(input)
+	}
+
+	collector := &candidateCollector{
+		atn:   getParserATN(),
+		memo:  make(map[ruleCall][]int),
+		calls: make(map[ruleCall]bool),
+		found: make(map[string]bool),
+	}
+	for _, tok := range tokens {
+		collector.tokens = append(collector.tokens, tok.GetTokenType())
+	}
+	collector.rule(collector.atn.ruleStarts[CQLParserRULE_cqlFilter], 0, 0, nil)
+	if len(collector.candidates) == 0 {
+		//-- the tokens before the caret do not start a filter: report the error of the parser
+		lexer = NewCqlLexer(antlr.NewInputStream(input))
+		lexer.RemoveErrorListeners()
+		parser := NewCQLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		parser.RemoveErrorListeners()
+		parser.AddErrorListener(parseErrors)
+		parser.CqlFilter()
+		return nil, parseErrors.Err(input)
+	}
+	c.Candidates = collector.candidates
+	sort.SliceStable(c.Candidates, func(i, j int) bool {
+		return c.Candidates[i].Token < c.Candidates[j].Token
+	})
+	c.Suggestions = suggestions(c, queryables, functionsOf(opts))
+	return c, nil
+}
+
+// suggestions returns the suggestions for the candidates which start with the prefix, ordered by kind
+func suggestions(c *Completion, queryables Queryables, functions map[string]Function) []Suggestion {
+	var all []Suggestion
+	for _, cand := range c.Candidates {
+		//-- a name is a property, a function or a coordinate dimension
+		switch rule := cand.Rules[len(cand.Rules)-1]; {
+		case rule == "propertyName":
+			//-- the keywords which can be property names are not suggested as such
+			if cand.Token == CQLParserIdentifier {
+				all = append(all, propertySuggestions(queryables, contains(cand.Rules, "geomExpression"))...)
+			}
+		case cand.Token != CQLParserIdentifier:
+			all = append(all, tokenSuggestions[cand.Token]...)
+		case rule == "function":
+			all = append(all, functionSuggestions(functions)...)
+		case rule == "dimension":
+			all = append(all, dimensionSuggestions...)
+		}
+	}
+	prefix := strings.ToLower(c.Prefix)
+	var result []Suggestion
+	seen := make(map[string]bool)
+	for _, s := range all {
+		if seen[s.Text] || !strings.HasPrefix(strings.ToLower(s.Text), prefix) {
+			continue
+		}
+		seen[s.Text] = true
+		result = append(result, s)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return kindOrder(result[i].Kind) < kindOrder(result[j].Kind)
+	})
+	return result
+}
+
+func kindOrder(kind string) int {
+	for i, k := range suggestionKinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(suggestionKinds)
+}
+
+// propertySuggestions returns the properties which can be used in a geometry expression or elsewhere,
+// in name order
+func propertySuggestions(queryables Queryables, geometry bool) []Suggestion {
+	names := make([]string, 0, len(queryables))
+	for name, q := range queryables {
+		if t := q.scalarType(); t == typeUnknown || (t == typeGeometry) == geometry {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	result := make([]Suggestion, 0, len(names))
+	for _, name := range names {
+		result = append(result, Suggestion{Text: formatPropertyName(name), Kind: "property", Detail: queryables[name].Detail()})
+	}
+	return result
+}
+
+// functionSuggestions returns the functions which can be called, in name order
+func functionSuggestions(functions map[string]Function) []Suggestion {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]Suggestion, 0, len(names))
+	for _, name := range names {
+		result = append(result, Suggestion{Text: strings.ToUpper(name), Kind: "function", Detail: functions[name].ResultType})
+	}
+	return result
+}
+
+// isWord reports whether token text is a name or keyword
+func isWord(text string) bool {
+	for i, r := range text {
+		if !(unicode.IsLetter(r) || (i > 0 && (unicode.IsDigit(r) || r == '_' || r == '$'))) {
+			return false
+		}
+	}
+	return text != ""
+}
+
+// ruleCall is the invocation of a rule, by its start state, at a token
+type ruleCall struct {
+	state, index, precedence int
+}
+
+// candidateCollector walks the parser ATN over the tokens before a caret,
+// and collects the tokens which can be matched at the caret
+type candidateCollector struct {
+	atn        *parserATN
+	tokens     []int
+	memo       map[ruleCall][]int
+	calls      map[ruleCall]bool
+	found      map[string]bool
+	candidates []Candidate
+}
+
+// rule matches a rule from a token, and returns the indexes of the tokens which can follow it.
+// Rules invoked before the caret are matched once: their candidates have the rules of the first invocation.
+func (c *candidateCollector) rule(start int, index int, precedence int, rules []string) []int {
+	call := ruleCall{start, index, precedence}
+	if ends, ok := c.memo[call]; ok && index < len(c.tokens) {
+		return ends
+	}
+	if c.calls[call] {
+		//-- the rule recurses without matching a token
+		return nil
+	}
+	c.calls[call] = true
+	defer delete(c.calls, call)
+	rules = append(rules[:len(rules):len(rules)], CQLParserParserStaticData.RuleNames[c.atn.states[start].rule])
+
+	type step struct{ state, index int }
+	var ends []int
+	visited := make(map[step]bool)
+	work := []step{{start, index}}
+	for len(work) > 0 {
+		s := work[len(work)-1]
+		work = work[:len(work)-1]
+		if visited[s] {
+			continue
+		}
+		visited[s] = true
+		state := c.atn.states[s.state]
+		if state.kind == atnRuleStop {
+			ends = append(ends, s.index)
+			continue
+		}
+		for _, t := range state.transitions {
+			switch t.kind {
+			case atnRule:
+				for _, end := range c.rule(t.target, s.index, t.precedence, rules) {
+					work = append(work, step{t.follow, end})
+				}
+			case atnPrecedence:
+				if t.precedence >= precedence {
+					work = append(work, step{t.target, s.index})
+				}
+			case atnEpsilon, atnPredicate, atnAction:
+				work = append(work, step{t.target, s.index})
+			default:
+				if s.index == len(c.tokens) {
+					c.add(t, rules)
+				} else if t.matches(c.tokens[s.index]) {
+					work = append(work, step{t.target, s.index + 1})
+				}
+			}
+		}
+	}
+	c.memo[call] = ends
+	return ends
+}
+
+// add records the tokens matched by a transition at the caret
+func (c *candidateCollector) add(t atnTransition, rules []string) {
+	if t.kind == atnNotSet || t.kind == atnWildcard {
+		//-- not used by the grammar
+		return
+	}
+	for _, r := range t.tokens {
+		for tokenType := r[0]; tokenType <= r[1]; tokenType++ {
+			key := strconv.Itoa(tokenType) + " " + strings.Join(rules, " ")
+			if !c.found[key] {
+				c.found[key] = true
+				c.candidates = append(c.candidates, Candidate{Token: tokenType, Rules: rules})
+			}
+		}
+	}
+}
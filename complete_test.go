@@ -0,0 +1,82 @@
+package cql2_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+// texts returns the texts of suggestions
+func texts(suggestions []cql2.Suggestion) []string {
+	result := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		result = append(result, s.Text)
+	}
+	return result
+}
+
+var _ = Describe("Complete", func() {
+	DescribeTable("suggests",
+		func(cqlStr string, prefix string, included []string, excluded []string) {
+			c, err := cql2.Complete(cqlStr, -1, queryables)
+			Expect(err).To(BeNil())
+			Expect(c.Prefix).To(Equal(prefix))
+			Expect(texts(c.Suggestions)).To(ContainElements(included))
+			for _, text := range excluded {
+				Expect(texts(c.Suggestions)).NotTo(ContainElement(text))
+			}
+		},
+		Entry("start of filter", "", "", []string{"name", "CASEI", "NOT", "S_INTERSECTS", "DWITHIN", "TIMESTAMP()", "("}, []string{"geom", "AND", "=", "EMPTY"}),
+		Entry("operators after property", "name ", "", []string{"=", "<>", "LIKE", "BETWEEN", "IN", "IS", "NOT", "AND", "+"}, []string{"name", "POINT"}),
+		Entry("logical operators after predicate", "name = 'x' ", "", []string{"AND", "OR"}, []string{"=", "LIKE"}),
+		Entry("property prefix", "na", "na", []string{"name"}, []string{"pop", "NOT"}),
+		Entry("keyword prefix", "pop be", "be", []string{"BETWEEN"}, []string{"AND"}),
+		Entry("operands", "pop >= ", "", []string{"pop", "area", "ACCENTI", "NOW()", "DATE()"}, []string{"geom", "AND"}),
+		Entry("geometry operands", "S_INTERSECTS(", "", []string{"geom", "POINT", "POLYGON", "BBOX", "ENVELOPE"}, []string{"name", "CASEI"}),
+		Entry("envelope prefix", "S_INTERSECTS(geom, env", "env", []string{"ENVELOPE"}, []string{"BBOX"}),
+		Entry("second geometry", "S_WITHIN(geom, POL", "POL", []string{"POLYGON"}, []string{"POINT"}),
+		Entry("geometry dimension", "S_WITHIN(geom, POINT ", "", []string{"Z", "M", "ZM", "EMPTY", "("}, []string{"geom"}),
+		Entry("end of coordinates", "S_WITHIN(geom, POINT(1 2", "", []string{")"}, []string{","}),
+		Entry("end of range", "pop BETWEEN 1 ", "", []string{"AND"}, []string{"OR"}),
+		Entry("list", "pop IN (1", "", []string{",", ")"}, []string{"AND"}),
+		Entry("truth values", "active IS ", "", []string{"NOT", "NULL", "TRUE", "UNKNOWN"}, []string{"AND"}),
+	)
+
+	It("finds candidate tokens and rules", func() {
+		c, err := cql2.Complete("S_INTERSECTS(geom, ", -1, nil)
+		Expect(err).To(BeNil())
+		Expect(c.Candidates).To(ContainElement(cql2.Candidate{
+			Token: cql2.CQLParserIdentifier,
+			Rules: []string{"cqlFilter", "booleanExpression", "booleanTerm", "predicate", "spatialPredicate", "geomExpression", "propertyName"},
+		}))
+	})
+
+	It("suggests declared functions", func() {
+		c, err := cql2.Complete("pop = ", -1, queryables, cql2.WithFunction("in_service", cql2.Function{SQLName: "app.in_service", NumArgs: 2, ResultType: "boolean"}))
+		Expect(err).To(BeNil())
+		Expect(c.Suggestions).To(ContainElement(cql2.Suggestion{Text: "IN_SERVICE", Kind: "function", Detail: "boolean"}))
+		Expect(texts(c.Suggestions)).To(ContainElements("CASEI", "ACCENTI"))
+
+		c, err = cql2.Complete("pop = ", -1, queryables)
+		Expect(err).To(BeNil())
+		Expect(texts(c.Suggestions)).NotTo(ContainElement("IN_SERVICE"))
+	})
+
+	It("completes before the caret", func() {
+		c, err := cql2.Complete("pop = 1 AND na = 'x'", 14, queryables)
+		Expect(err).To(BeNil())
+		Expect(c.Prefix).To(Equal("na"))
+		Expect(c.Start).To(Equal(12))
+		Expect(texts(c.Suggestions)).To(Equal([]string{"name"}))
+	})
+
+	It("reports syntax errors before the caret", func() {
+		_, err := cql2.Complete("pop = = 1 AND ", -1, queryables)
+		var syntaxErr *cql2.SyntaxError
+		Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+		Expect(syntaxErr.Column).To(Equal(6))
+	})
+})
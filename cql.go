@@ -18,16 +18,53 @@ package cql2
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/rs/zerolog/log"
 )
 
-func TranspileToSQL(cqlStr string, filterSRID int, sourceSRID int) (string, error) {
+// Option customizes the SQL generated by TranspileToSQL
+type Option func(*cqlListener)
+
+func TranspileToSQL(cqlStr string, filterSRID int, sourceSRID int, opts ...Option) (string, error) {
+	sql, _, err := transpile(cqlStr, filterSRID, sourceSRID, false, opts)
+	return sql, err
+}
+
+// TranspileToParameterizedSQL is TranspileToSQL with the values of string and temporal literals
+// passed as parameters, referenced by the placeholders $1, $2, ... in the SQL.
+func TranspileToParameterizedSQL(cqlStr string, filterSRID int, sourceSRID int, opts ...Option) (string, []interface{}, error) {
+	return transpile(cqlStr, filterSRID, sourceSRID, true, opts)
+}
+
+func transpile(cqlStr string, filterSRID int, sourceSRID int, parameterize bool, opts []Option) (string, []interface{}, error) {
 	if len(cqlStr) < 1 {
-		return "", nil
+		return "", nil, nil
+	}
+	tree, err := parseCQL(cqlStr)
+	if err != nil {
+		return "", nil, err
 	}
+	//-- parse the CQL expression
+	listener := NewCqlListener(filterSRID, sourceSRID)
+	for _, opt := range opts {
+		opt(listener)
+	}
+	if parameterize {
+		listener.paramIndex = make(map[antlr.ParserRuleContext]int)
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	if listener.err != nil {
+		return "", nil, listener.err
+	}
+	return listener.GetSQL(), listener.params, nil
+}
+
+// parseCQL parses CQL text into a parse tree
+func parseCQL(cqlStr string) (ICqlFilterContext, error) {
 	// Setup the input
 	is := antlr.NewInputStream(cqlStr)
 
@@ -45,17 +82,32 @@ func TranspileToSQL(cqlStr string, filterSRID int, sourceSRID int) (string, erro
 	parser.AddErrorListener(parseErrors)
 
 	tree := parser.CqlFilter()
-	//-- parse the CQL expression
-	listener := NewCqlListener(filterSRID, sourceSRID)
-	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
-
-	if parseErrors.errorCount > 0 {
+	//-- a tree with syntax errors is incomplete, so do not translate it
+	if err := parseErrors.Err(cqlStr); err != nil {
 		log.Debug().Str("Message", parseErrors.msg).Msg("CQL parser error")
-		msg := syntaxErrorMsg(cqlStr, parseErrors.col)
-		err := fmt.Errorf("CQL syntax error: %s", msg)
-		return "", err
+		return nil, err
 	}
-	return listener.GetSQL(), nil
+	return tree, nil
+}
+
+// SyntaxError reports CQL text which does not match the grammar.
+// Line and Column give the position of the first offending token
+// (line is 1-based, column is 0-based, as reported by the parser),
+// Msg is the message of the parser, and Near quotes the text around the position.
+// Errors found after parsing, such as unknown functions, are positioned at the
+// offending token and have no Near text.
+type SyntaxError struct {
+	Line   int
+	Column int
+	Msg    string
+	Near   string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Near == "" {
+		return "CQL syntax error: " + e.Msg
+	}
+	return "CQL syntax error: " + e.Near
 }
 
 func syntaxErrorMsg(input string, col int) string {
@@ -100,6 +152,20 @@ func (l *CqlErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSym
 	l.msg = msg
 }
 
+// Err returns the first error reported while lexing and parsing cqlStr, as a SyntaxError,
+// or nil if there was none
+func (l *CqlErrorListener) Err(cqlStr string) error {
+	if l.errorCount == 0 {
+		return nil
+	}
+	return &SyntaxError{
+		Line:   l.line,
+		Column: l.col,
+		Msg:    l.msg,
+		Near:   syntaxErrorMsg(cqlStr, l.col),
+	}
+}
+
 func (l *CqlErrorListener) ReportAmbiguity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, exact bool, ambigAlts *antlr.BitSet, configs *antlr.ATNConfigSet) {
 	l.errorCount += 1
 }
@@ -120,35 +186,158 @@ type cqlListener struct {
 	filterSRID int
 	// SRID for source CRS
 	sourceSRID int
+	// SRID of the geometry property in the spatial predicate being translated
+	targetSRID int
+	// SRID of the geometry literal being translated
+	geomSRID int
+	// the spatial predicate being translated compares geography values
+	targetGeography bool
 
 	// final result SQL
 	sql string
+	// first error found while translating
+	err error
+
+	// check geometry literals before generating SQL
+	validateGeom bool
+	// maximum number of vertices in a geometry literal (0 = no limit)
+	maxVertices int
+	// generate spatial predicates which can use a spatial index
+	indexPrefilter bool
+	// number of decimals to round literal coordinates to (-1 = no rounding)
+	coordDecimals int
+	// maximum number of vertices in a simplified line or ring (0 = no simplification)
+	simplifyVertices int
+	// functions which can be called in the filter
+	functions map[string]Function
+	// SRIDs of the geographic (longitude/latitude) CRSs
+	geographicSRIDs map[int]bool
+	// declared types of properties
+	queryables Queryables
+	// parameter numbers of the literals passed as parameters (nil = literals are inlined)
+	paramIndex map[antlr.ParserRuleContext]int
+	// parameter values
+	params []interface{}
 }
 
 func NewCqlListener(filterSRID int, sourceSRID int) *cqlListener {
 	this := new(cqlListener)
 	this.filterSRID = filterSRID
 	this.sourceSRID = sourceSRID
+	this.targetSRID = sourceSRID
+	this.coordDecimals = -1
+	this.functions = sqlFunctionForCql
+	this.geographicSRIDs = geographicSRIDs
 	return this
 }
 func (l *cqlListener) GetSQL() string {
 	return l.sql
 }
 
+// setError records an error found during the tree walk (only the first is kept)
+func (l *cqlListener) setError(err error) {
+	if l.err == nil {
+		l.err = err
+	}
+}
+
+// tokenSyntaxError returns a SyntaxError at a token of text which parses but cannot be translated
+func tokenSyntaxError(node antlr.TerminalNode, format string, args ...interface{}) error {
+	tok := node.GetSymbol()
+	return &SyntaxError{
+		Line:   tok.GetLine(),
+		Column: tok.GetColumn(),
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
 func (l *cqlListener) sqlGeometryLiteral(wkt string) string {
-	sql := fmt.Sprintf("'SRID=%d;%s'::geometry", l.filterSRID, wkt)
+	sql := fmt.Sprintf("'SRID=%d;%s'::geometry", l.geomSRID, wkt)
 	return sql
 }
 
 func (l *cqlListener) sqlEnvelopeLiteral(xmin string, ymin string, xmax string, ymax string) string {
-	return fmt.Sprintf("ST_MakeEnvelope(%s,%s,%s,%s,%d)", xmin, ymin, xmax, ymax, l.filterSRID)
+	//-- in a geographic CRS xmin > xmax denotes a box crossing the antimeridian,
+	//-- which is split into boxes on either side of it
+	if l.isGeographicSRID(l.geomSRID) && isGreater(xmin, xmax) {
+		east := fmt.Sprintf("ST_MakeEnvelope(%s,%s,180,%s,%d)", xmin, ymin, ymax, l.geomSRID)
+		west := fmt.Sprintf("ST_MakeEnvelope(-180,%s,%s,%s,%d)", ymin, xmax, ymax, l.geomSRID)
+		return fmt.Sprintf("ST_Collect(%s,%s)", east, west)
+	}
+	return fmt.Sprintf("ST_MakeEnvelope(%s,%s,%s,%s,%d)", xmin, ymin, xmax, ymax, l.geomSRID)
+}
+
+func (l *cqlListener) sqlEnvelope3DLiteral(xmin string, ymin string, zmin string, xmax string, ymax string, zmax string) string {
+	if l.isGeographicSRID(l.geomSRID) && isGreater(xmin, xmax) {
+		east := l.sqlBox3D(xmin, ymin, zmin, "180", ymax, zmax)
+		west := l.sqlBox3D("-180", ymin, zmin, xmax, ymax, zmax)
+		return fmt.Sprintf("ST_Collect(%s,%s)", east, west)
+	}
+	return l.sqlBox3D(xmin, ymin, zmin, xmax, ymax, zmax)
+}
+
+func (l *cqlListener) sqlBox3D(xmin string, ymin string, zmin string, xmax string, ymax string, zmax string) string {
+	box := fmt.Sprintf("ST_3DMakeBox(ST_MakePoint(%s,%s,%s),ST_MakePoint(%s,%s,%s))", xmin, ymin, zmin, xmax, ymax, zmax)
+	return fmt.Sprintf("ST_SetSRID(%s::geometry,%d)", box, l.geomSRID)
+}
+
+// SRIDs of commonly used geographic (longitude/latitude) CRSs.
+// Others are declared with WithGeographicSRIDs.
+var geographicSRIDs = map[int]bool{
+	4019: true, // GRS 1980
+	4148: true, // Hartebeesthoek94
+	4167: true, // NZGD2000
+	4258: true, // ETRS89
+	4269: true, // NAD83
+	4283: true, // GDA94
+	4326: true, // WGS 84
+	4490: true, // CGCS2000
+	4612: true, // JGD2000
+	4617: true, // NAD83(CSRS)
+	4618: true, // SAD69
+	4674: true, // SIRGAS 2000
+	4759: true, // NAD83(NSRS2007)
+	4979: true, // WGS 84 (3D)
+	6318: true, // NAD83(2011)
+	7844: true, // GDA2020
+}
+
+func (l *cqlListener) isGeographicSRID(srid int) bool {
+	return l.geographicSRIDs[srid]
+}
+
+// WithGeographicSRIDs declares the SRIDs of geographic (longitude/latitude) CRSs
+// besides the commonly used ones, such as WGS 84 (4326) and ETRS89 (4258).
+// In a geographic CRS an envelope whose west bound is greater than its east bound
+// crosses the antimeridian, and geometry validation checks longitude and latitude ranges.
+func WithGeographicSRIDs(srids ...int) Option {
+	return func(l *cqlListener) {
+		geographic := make(map[int]bool, len(l.geographicSRIDs)+len(srids))
+		for srid := range l.geographicSRIDs {
+			geographic[srid] = true
+		}
+		for _, srid := range srids {
+			geographic[srid] = true
+		}
+		l.geographicSRIDs = geographic
+	}
+}
+
+// isGreater reports whether numeric text a is greater than b
+func isGreater(a string, b string) bool {
+	fa, errA := strconv.ParseFloat(a, 64)
+	fb, errB := strconv.ParseFloat(b, 64)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return fa > fb
 }
 
 func (l *cqlListener) sqlTransformCrs(sql string) string {
-	if l.sourceSRID == l.filterSRID {
+	if l.targetSRID == l.geomSRID {
 		return sql
 	}
-	return fmt.Sprintf("ST_Transform(%s,%d)", sql, l.sourceSRID)
+	return fmt.Sprintf("ST_Transform(%s,%d)", sql, l.targetSRID)
 }
 
 // helper function to avoid nil pointer problems
@@ -237,10 +426,19 @@ func (l *cqlListener) ExitBoolExprOr(ctx *BoolExprOrContext) {
 
 func (l *cqlListener) ExitBoolExprParen(ctx *BoolExprParenContext) {
 	sql := "(" + sqlFor(ctx.BooleanExpression()) + ")"
+	if ctx.TruthValue() != nil {
+		sql = sqlTruthTest(ctx, sql)
+	}
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitBoolExprNot(ctx *BoolExprNotContext) {
+	if l.indexPrefilter {
+		if sp := spatialPredicateOf(ctx.BooleanExpression()); sp != nil {
+			ctx.SetSql(l.indexedSpatialSQL(sp, true))
+			return
+		}
+	}
 	sql := "NOT " + sqlFor(ctx.BooleanExpression())
 	ctx.SetSql(sql)
 }
@@ -249,12 +447,39 @@ func (l *cqlListener) ExitBooleanTerm(ctx *BooleanTermContext) {
 	var sql string
 	if ctx.BooleanLiteral() != nil {
 		sql = getText(ctx.BooleanLiteral())
+	} else if ctx.PropertyName() != nil {
+		l.checkPropertyType(ctx.PropertyName(), typeBoolean, "a boolean term")
+		sql = l.sqlProperty(getText(ctx.PropertyName()))
+	} else if ctx.Function() != nil {
+		name := getNodeText(ctx.Function().Identifier())
+		if t := l.functions[strings.ToLower(name)].ResultType; t != typeUnknown && t != typeBoolean {
+			l.typeError(ctx.Function(), "function %s returns %s, not boolean", strings.ToUpper(name), t)
+		}
+		sql = sqlFor(ctx.Function())
 	} else {
 		sql = sqlFor(ctx.Predicate())
+		if ctx.TruthValue() != nil {
+			sql = "(" + strings.TrimSpace(sql) + ")"
+		}
+	}
+	if ctx.TruthValue() != nil {
+		sql = sqlTruthTest(ctx, sql)
 	}
 	ctx.SetSql(sql)
 }
 
+// sqlTruthTest returns the SQL testing the truth value of a boolean expression: IS [NOT] TRUE, FALSE or UNKNOWN
+func sqlTruthTest(ctx interface {
+	NOT() antlr.TerminalNode
+	TruthValue() ITruthValueContext
+}, sql string) string {
+	not := ""
+	if ctx.NOT() != nil {
+		not = " NOT"
+	}
+	return sql + " IS" + not + " " + strings.ToUpper(getText(ctx.TruthValue()))
+}
+
 func (l *cqlListener) ExitPredicate(ctx *PredicateContext) {
 	var sql string
 	if ctx.ComparisonPredicate() != nil {
@@ -295,23 +520,47 @@ func (l *cqlListener) ExitPredicateIsNull(ctx *PredicateIsNullContext) {
 }
 
 func (l *cqlListener) ExitBinaryComparisonPredicate(ctx *BinaryComparisonPredicateContext) {
-	expr1 := sqlFor(ctx.left)
-	expr2 := sqlFor(ctx.right)
+	expr1, expr2 := l.comparedSQL(ctx.left, ctx.right)
 	op := ctx.op.GetText()
+	if op == "=" || op == "<>" {
+		l.checkEnum(ctx.left, ctx.right)
+		l.checkEnum(ctx.right, ctx.left)
+	}
 	sql := expr1 + " " + op + " " + expr2
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitLiteralName(ctx *LiteralNameContext) {
-	sql := quotedName(getText(ctx.PropertyName()))
+	sql := l.sqlProperty(getText(ctx.PropertyName()))
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitLiteralString(ctx *LiteralStringContext) {
-	sql := quotedText(getText(ctx.CharacterLiteral()))
+	sql := l.sqlStringLiteral(ctx.CharacterLiteral(), quotedText(getText(ctx.CharacterLiteral())))
 	ctx.SetSql(sql)
 }
 
+// sqlStringLiteral returns the SQL of a quoted string for a literal
+func (l *cqlListener) sqlStringLiteral(ctx antlr.ParserRuleContext, quoted string) string {
+	return l.sqlLiteral(ctx, quoted, strings.ReplaceAll(quoted[1:len(quoted)-1], "''", "'"))
+}
+
+// sqlLiteral returns the SQL of a literal with the given value:
+// its quoted text, or the placeholder of the parameter holding the value
+func (l *cqlListener) sqlLiteral(ctx antlr.ParserRuleContext, quoted string, value string) string {
+	if l.paramIndex == nil {
+		return quoted
+	}
+	n, ok := l.paramIndex[ctx]
+	if !ok {
+		l.params = append(l.params, nil)
+		n = len(l.params)
+		l.paramIndex[ctx] = n
+	}
+	l.params[n-1] = value
+	return fmt.Sprintf("$%d", n)
+}
+
 func (l *cqlListener) ExitLiteralNumeric(ctx *LiteralNumericContext) {
 	sql := getText(ctx.NumericLiteral())
 	ctx.SetSql(sql)
@@ -327,6 +576,30 @@ func (l *cqlListener) ExitLiteralTemporal(ctx *LiteralTemporalContext) {
 	ctx.SetSql(sql)
 }
 
+func (l *cqlListener) ExitFunctionValue(ctx *FunctionValueContext) {
+	sql := sqlFor(ctx.Function())
+	ctx.SetSql(sql)
+}
+
+func (l *cqlListener) ExitFunction(ctx *FunctionContext) {
+	fun, err := checkFunction(ctx, l.functions)
+	if err != nil {
+		l.setError(err)
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString(fun.SQLName)
+	sb.WriteString("(")
+	for i, arg := range ctx.AllScalarExpression() {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(sqlFor(arg))
+	}
+	sb.WriteString(")")
+	ctx.SetSql(sb.String())
+}
+
 func (l *cqlListener) ExitScalarVal(ctx *ScalarValContext) {
 	sql := sqlFor(ctx.val)
 	ctx.SetSql(sql)
@@ -341,13 +614,22 @@ func (l *cqlListener) ExitScalarExpr(ctx *ScalarExprContext) {
 	expr1 := sqlFor(ctx.left)
 	expr2 := sqlFor(ctx.right)
 	op := ctx.op.GetText()
+	if op != "||" {
+		for _, operand := range []IScalarExpressionContext{ctx.left, ctx.right} {
+			if t := l.declaredType(operand); t != typeUnknown && t != typeNumber {
+				name := propertyNameOf(operand)
+				l.propertyError(operand, name, "property %s is %s, operator %s requires number", name, t, op)
+			}
+		}
+	}
 	sql := expr1 + " " + op + " " + expr2
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitIsLikePredicate(ctx *IsLikePredicateContext) {
+	l.checkStringOperands(ctx.AllScalarExpression(), "LIKE")
 	var sb strings.Builder
-	sb.WriteString(quotedName(getText(ctx.PropertyName())))
+	sb.WriteString(sqlFor(ctx.ScalarExpression(0)))
 	if ctx.NOT() != nil {
 		sb.WriteString(" NOT")
 	}
@@ -356,70 +638,138 @@ func (l *cqlListener) ExitIsLikePredicate(ctx *IsLikePredicateContext) {
 		op = " ILIKE "
 	}
 	sb.WriteString(op)
-	sb.WriteString(quotedText(getText(ctx.CharacterLiteral())))
+	pattern := sqlFor(ctx.ScalarExpression(1))
+	if lit := stringLiteralOf(ctx.ScalarExpression(1)); lit != nil {
+		var err error
+		pattern, err = likePattern(lit.CharacterStringLiteral())
+		if err != nil {
+			l.setError(err)
+		} else {
+			pattern = l.sqlStringLiteral(lit, pattern)
+		}
+	}
+	sb.WriteString(pattern)
+	//-- CQL2 patterns use backslash as the escape character
+	sb.WriteString(" ESCAPE '\\'")
 	ctx.SetSql(sb.String())
 }
 
+// stringLiteralOf returns the string literal a scalar expression consists of, if any
+func stringLiteralOf(expr IScalarExpressionContext) ICharacterLiteralContext {
+	val, ok := expr.(*ScalarValContext)
+	if !ok {
+		return nil
+	}
+	str, ok := val.val.(*LiteralStringContext)
+	if !ok {
+		return nil
+	}
+	return str.CharacterLiteral()
+}
+
+// likePattern converts a quoted CQL2 LIKE pattern to a SQL pattern with backslash escapes.
+// In CQL2 a backslash escapes the wildcards % and _ and itself;
+// before any other character it is redundant and is removed.
+func likePattern(lit antlr.TerminalNode) (string, error) {
+	pattern := lit.GetText()
+	var sb strings.Builder
+	escaped := false
+	//-- the pattern text is quoted
+	for _, c := range pattern[1 : len(pattern)-1] {
+		if escaped {
+			if c == '%' || c == '_' || c == '\\' {
+				sb.WriteRune('\\')
+			}
+			sb.WriteRune(c)
+			escaped = false
+		} else if c == '\\' {
+			escaped = true
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+	if escaped {
+		return "", tokenSyntaxError(lit, "LIKE pattern %s ends with an escape character", pattern)
+	}
+	return quotedText("'" + sb.String() + "'"), nil
+}
+
 func (l *cqlListener) ExitIsBetweenPredicate(ctx *IsBetweenPredicateContext) {
-	lhs := sqlFor(ctx.ScalarExpression(0))
+	lhs, expr1 := l.comparedSQL(ctx.ScalarExpression(0), ctx.ScalarExpression(1))
+	_, expr2 := l.comparedSQL(ctx.ScalarExpression(0), ctx.ScalarExpression(2))
+	l.checkBetweenBounds(ctx)
 	not := ""
 	if ctx.NOT() != nil {
 		not = " NOT"
 	}
-	expr1 := sqlFor(ctx.ScalarExpression(1))
-	expr2 := sqlFor(ctx.ScalarExpression(2))
 	sql := " " + lhs + not + " BETWEEN " + expr1 + " AND " + expr2
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitIsNullPredicate(ctx *IsNullPredicateContext) {
-	prop := quotedName(getText(ctx.PropertyName()))
+	expr := sqlFor(ctx.ScalarExpression())
 	not := ""
 	if ctx.NOT() != nil {
 		not = " NOT"
 	}
-	sql := " " + prop + " IS" + not + " NULL"
+	sql := " " + expr + " IS" + not + " NULL"
 	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitIsInListPredicate(ctx *IsInListPredicateContext) {
+	exprs := ctx.AllScalarExpression()
+	//-- values compared with a declared property are cast to its type
+	declared := false
+	for _, expr := range exprs {
+		declared = declared || l.declaredType(expr) != typeUnknown
+	}
+	if !declared {
+		l.checkSameType(exprs, "IN list")
+	}
+	//-- the value is cast once, to the type of the properties of the list
+	lhs, _ := l.typedOperand(exprs[0], l.listProperty(exprs[1:]))
+	values := make([]string, len(exprs)-1)
+	for i, expr := range exprs[1:] {
+		_, values[i] = l.comparedSQL(exprs[0], expr)
+		l.checkEnum(exprs[0], expr)
+	}
 	var sb strings.Builder
-	sb.WriteString(quotedName(getText(ctx.PropertyName())))
+	sb.WriteString(lhs)
 	if ctx.NOT() != nil {
 		sb.WriteString(" NOT")
 	}
 	sb.WriteString(" IN (")
-	inPredValueList(ctx, &sb)
+	sb.WriteString(strings.Join(values, ","))
 	sb.WriteString(") ")
 	sql := sb.String()
 	ctx.SetSql(sql)
 }
 
-func inPredValueList(ctx *IsInListPredicateContext, sb *strings.Builder) {
-	//-- numeric literal list?
-	nums := ctx.AllNumericLiteral()
-	if len(nums) > 0 {
-		for i, num := range nums {
-			if i > 0 {
-				sb.WriteString(",")
-			}
-			sb.WriteString(num.GetText())
+// listProperty returns the first declared property of the items of an IN list,
+// and reports an error if the declared properties have different types
+func (l *cqlListener) listProperty(items []IScalarExpressionContext) string {
+	first := ""
+	for _, item := range items {
+		name := propertyNameOf(item)
+		if l.propertyType(name) == typeUnknown {
+			continue
 		}
-		return
-	}
-	//-- must be string literal list
-	strs := ctx.AllCharacterLiteral()
-	if len(strs) > 0 {
-		for i, s := range strs {
-			if i > 0 {
-				sb.WriteString(",")
-			}
-			sb.WriteString(quotedText(s.GetText()))
+		if first == "" {
+			first = name
+		} else if !comparableTypes(l.propertyType(first), l.propertyType(name)) {
+			l.propertyError(item, name, "IN list mixes property %s of type %s and property %s of type %s",
+				first, l.propertyType(first), name, l.propertyType(name))
+			break
 		}
 	}
+	return first
 }
 
 func (l *cqlListener) ExitSpatialPredicate(ctx *SpatialPredicateContext) {
+	if l.indexPrefilter {
+		ctx.SetSql(l.indexedSpatialSQL(ctx, false))
+		return
+	}
 	var sb strings.Builder
 	sb.WriteString(toPostGISFunction(ctx.SpatialOperator().GetText()))
 	sb.WriteString("(")
@@ -431,6 +781,10 @@ func (l *cqlListener) ExitSpatialPredicate(ctx *SpatialPredicateContext) {
 }
 
 func (l *cqlListener) ExitDistancePredicate(ctx *DistancePredicateContext) {
+	if l.indexPrefilter {
+		ctx.SetSql(l.indexedDistanceSQL(ctx))
+		return
+	}
 	var sb strings.Builder
 	sb.WriteString(toPostGISFunction(ctx.DistanceOperator().GetText()))
 	sb.WriteString("(")
@@ -446,22 +800,64 @@ func (l *cqlListener) ExitDistancePredicate(ctx *DistancePredicateContext) {
 func (l *cqlListener) ExitGeomExpression(ctx *GeomExpressionContext) {
 	var sb strings.Builder
 	if ctx.PropertyName() != nil {
-		sb.WriteString(quotedName(getText(ctx.PropertyName())))
+		l.checkPropertyType(ctx.PropertyName(), typeGeometry, "a spatial predicate")
+		sb.WriteString(l.sqlProperty(getText(ctx.PropertyName())))
+		//-- a geography column is compared as geometry by functions without a geography version
+		if l.isGeography(ctx.PropertyName()) && !l.targetGeography {
+			sb.WriteString("::geometry")
+		}
+		ctx.SetSql(sb.String())
+		return
+	}
+	if wkb := wkbLiteral(ctx); wkb != nil {
+		sb.WriteString(l.sqlWkbLiteral(wkb))
+	} else if ctx.GeoJsonLiteral() != nil {
+		sb.WriteString(l.sqlGeoJSONLiteral(ctx.GeoJsonLiteral()))
 	} else {
 		sb.WriteString(sqlFor(ctx.GeomLiteral()))
 	}
-	ctx.SetSql(sb.String())
+	sql := sb.String()
+	if l.targetGeography {
+		sql = sqlGeography(sql)
+	}
+	ctx.SetSql(sql)
 }
 
 func (l *cqlListener) ExitTemporalLiteral(ctx *TemporalLiteralContext) {
+	sqlType := "timestamp"
+	if isDateLiteral(ctx) {
+		sqlType = "date"
+	}
+	value := temporalValue(ctx)
+	sql := fmt.Sprintf("%s '%s'", sqlType, value)
+	if l.paramIndex != nil {
+		sql = l.sqlLiteral(ctx, sql, value) + "::" + sqlType
+	}
+	//TODO: handle NOW()
+	ctx.SetSql(sql)
+}
+
+// temporalValue returns the value of a temporal literal, as a Postgres timestamp string
+func temporalValue(ctx ITemporalLiteralContext) string {
 	val := strings.ToUpper(ctx.GetText())
-	//var sql string
 	if strings.HasPrefix(val, "NOW") {
 		val = "NOW"
 	}
-	sql := fmt.Sprintf("timestamp '%s'", val)
-	//TODO: handle NOW()
-	ctx.SetSql(sql)
+	//-- TIMESTAMP('...') or DATE('...')
+	if start := strings.Index(val, "'"); start >= 0 {
+		val = val[start+1 : strings.LastIndex(val, "'")]
+	}
+	return val
+}
+
+// isDateLiteral reports whether a temporal literal is a date: a DATE('...'),
+// or an instant without time such as 2020-01-01
+func isDateLiteral(ctx ITemporalLiteralContext) bool {
+	text := strings.ToUpper(ctx.GetText())
+	if strings.HasPrefix(text, "DATE") {
+		return true
+	}
+	return !strings.HasPrefix(text, "TIMESTAMP") && !strings.HasPrefix(text, "NOW") && !strings.Contains(text, "T")
 }
 
 func (l *cqlListener) ExitGeomLiteral(ctx *GeomLiteralContext) {
@@ -469,44 +865,90 @@ func (l *cqlListener) ExitGeomLiteral(ctx *GeomLiteralContext) {
 	var sql string
 	if ok {
 		nums := envCtx.AllNumericLiteral()
-		b1 := nums[0].GetText()
-		b2 := nums[1].GetText()
-		b3 := nums[2].GetText()
-		b4 := nums[3].GetText()
-		sql = l.sqlEnvelopeLiteral(b1, b2, b3, b4)
+		if len(nums) == 6 {
+			var b [6]string
+			for i, num := range nums {
+				b[i] = l.formatOrdinate(num.GetText())
+			}
+			sql = l.sqlEnvelope3DLiteral(b[0], b[1], b[2], b[3], b[4], b[5])
+		} else {
+			b1 := l.formatOrdinate(nums[0].GetText())
+			b2 := l.formatOrdinate(nums[1].GetText())
+			b3 := l.formatOrdinate(nums[2].GetText())
+			b4 := l.formatOrdinate(nums[3].GetText())
+			sql = l.sqlEnvelopeLiteral(b1, b2, b3, b4)
+		}
 	} else {
-		wkt := getGeomText(ctx)
+		if l.validateGeom {
+			if _, isTop := ctx.GetParent().(*GeomExpressionContext); isTop {
+				l.validateGeomLiteral(ctx)
+			}
+		}
+		wkt := l.getGeomText(ctx)
 		sql = l.sqlGeometryLiteral(wkt)
 	}
 	sql = l.sqlTransformCrs(sql)
 	ctx.SetSql(sql)
 }
 
-func getGeomText(ctx *GeomLiteralContext) string {
+var geomDimensions = map[string]bool{
+	"Z":  true,
+	"M":  true,
+	"ZM": true,
+}
+
+func (l *cqlListener) ExitDimension(ctx *DimensionContext) {
+	if err := checkDimension(ctx); err != nil {
+		l.setError(err)
+	}
+}
+
+// checkDimension returns an error if a geometry dimension is not Z, M or ZM
+func checkDimension(ctx IDimensionContext) error {
+	dim := getNodeText(ctx.Identifier())
+	if !geomDimensions[strings.ToUpper(dim)] {
+		return tokenSyntaxError(ctx.Identifier(), "invalid geometry dimension %q (expected Z, M or ZM)", dim)
+	}
+	return nil
+}
+
+func (l *cqlListener) getGeomText(ctx *GeomLiteralContext) string {
 	trees := ctx.GetChildren()
 	var sb strings.Builder
-	extractGeomText(trees, &sb)
+	l.extractGeomText(trees, &sb)
 	return sb.String()
 }
 
-func extractGeomText(trees []antlr.Tree, sb *strings.Builder) {
+func (l *cqlListener) extractGeomText(trees []antlr.Tree, sb *strings.Builder) {
 	isPrevNumeric := false
 	for _, t := range trees {
+		if cl, ok := t.(*CoordListContext); ok && l.isSimplified(cl) {
+			l.writeSimplifiedCoordList(cl, sb)
+			isPrevNumeric = false
+			continue
+		}
 		tn, ok := t.(antlr.TerminalNode)
 		if ok {
 			//-- add a blank between consecutive numbers to separate them
-			if tn.GetSymbol().GetTokenType() == CQLParserNumericLiteral {
+			tokenType := tn.GetSymbol().GetTokenType()
+			if tokenType == CQLParserIdentifier || tokenType == CQLParserEMPTY {
+				//-- dimension (Z, M, ZM) and EMPTY are separated from the geometry type
+				sb.WriteString(" ")
+				isPrevNumeric = false
+			} else if tokenType == CQLParserNumericLiteral {
 				if isPrevNumeric {
 					sb.WriteString(" ")
 				}
 				isPrevNumeric = true
+				sb.WriteString(l.formatOrdinate(tn.GetText()))
+				continue
 			} else {
 				isPrevNumeric = false
 			}
 			sb.WriteString(strings.ToUpper(tn.GetText()))
 		} else {
 			ch := t.GetChildren()
-			extractGeomText(ch, sb)
+			l.extractGeomText(ch, sb)
 		}
 	}
 }
@@ -524,8 +966,61 @@ var pgFunctionForCql = map[string]string{
 	"dwithin": "ST_DWithin",
 }
 
+// Function describes a SQL function which can be called in a CQL filter
+type Function struct {
+	// name of the SQL function
+	SQLName string
+	// number of arguments
+	NumArgs int
+	// type of the function result ("string", "number", "boolean", "timestamp"),
+	// or empty if unknown
+	ResultType string
+}
+
+// SQL functions implementing CQL functions
+var sqlFunctionForCql = map[string]Function{
+	"casei":   {"lower", 1, typeString},
+	"accenti": {"unaccent", 1, typeString},
+}
+
+// checkFunction returns the function called,
+// or an error if it is unknown or called with the wrong number of arguments
+func checkFunction(ctx IFunctionContext, functions map[string]Function) (Function, error) {
+	name := getNodeText(ctx.Identifier())
+	fun, ok := functions[strings.ToLower(name)]
+	if !ok {
+		return fun, tokenSyntaxError(ctx.Identifier(), "unknown function %q", name)
+	}
+	if len(ctx.AllScalarExpression()) != fun.NumArgs {
+		return fun, tokenSyntaxError(ctx.Identifier(), "function %s requires %d argument(s)", strings.ToUpper(name), fun.NumArgs)
+	}
+	return fun, nil
+}
+
+// WithFunction makes a SQL function available in filters under the given (case-insensitive) name
+func WithFunction(name string, fun Function) Option {
+	return func(l *cqlListener) {
+		functions := make(map[string]Function, len(l.functions)+1)
+		for n, f := range l.functions {
+			functions[n] = f
+		}
+		functions[strings.ToLower(name)] = fun
+		l.functions = functions
+	}
+}
+
+// functionsOf returns the functions which can be called in a filter translated with options
+func functionsOf(opts []Option) map[string]Function {
+	l := NewCqlListener(0, 0)
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l.functions
+}
+
 func toPostGISFunction(cqlFunName string) string {
-	cqlNameLow := strings.ToLower(cqlFunName)
+	//-- CQL2 names spatial operators with an S_ prefix
+	cqlNameLow := strings.TrimPrefix(strings.ToLower(cqlFunName), "s_")
 	if fun, ok := pgFunctionForCql[cqlNameLow]; ok {
 		return fun
 	}
@@ -1,6 +1,7 @@
 package cql2_test
 
 import (
+	"errors"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -18,7 +19,7 @@ var _ = Describe("CQL2", func() {
 			actual = strings.TrimSpace(actual)
 			Expect(actual).To(Equal(sql))
 		},
-		Entry("between", "1990-01-01 BETWEEN time_start AND time_end", "timestamp '1990-01-01' BETWEEN \"time_start\" AND \"time_end\""),
+		Entry("between", "1990-01-01 BETWEEN time_start AND time_end", "date '1990-01-01' BETWEEN \"time_start\" AND \"time_end\""),
 		Entry("empty", "", ""),
 		Entry("greater than property", "id > tt", "\"id\" > \"tt\""),
 		Entry("greater than constant", "id > 1", "\"id\" > 1"),
@@ -30,16 +31,32 @@ var _ = Describe("CQL2", func() {
 		Entry("equal negative number", "id = -1.2345", "\"id\" = -1.2345"),
 		Entry("equal property", "id = id2", "\"id\" = \"id2\""),
 		Entry("equal string", "id = 'foo'", "\"id\" = 'foo'"),
-		Entry("like string", "id LIKE 'foo'", "\"id\" LIKE 'foo'"),
-		Entry("case-insensitive like string", "id ILIKE 'foo'", "\"id\" ILIKE 'foo'"),
-		Entry("case-insensitvie like wildcard", "id ILIKE '%Ca%'", "\"id\" ILIKE '%Ca%'"),
+		Entry("like string", "id LIKE 'foo'", "\"id\" LIKE 'foo' ESCAPE '\\'"),
+		Entry("case-insensitive like string", "id ILIKE 'foo'", "\"id\" ILIKE 'foo' ESCAPE '\\'"),
+		Entry("case-insensitvie like wildcard", "id ILIKE '%Ca%'", "\"id\" ILIKE '%Ca%' ESCAPE '\\'"),
+		Entry("like concatenation", "name || ' ' || surname LIKE 'Jo%'", "\"name\" || ' ' || \"surname\" LIKE 'Jo%' ESCAPE '\\'"),
+		Entry("like property", "name NOT LIKE pattern", "\"name\" NOT LIKE \"pattern\" ESCAPE '\\'"),
+		Entry("like casei", "CASEI(name) LIKE casei('jo%')", "lower(\"name\") LIKE lower('jo%') ESCAPE '\\'"),
+		Entry("like accenti", "ACCENTI(name) ILIKE 'jose'", "unaccent(\"name\") ILIKE 'jose' ESCAPE '\\'"),
+		Entry("string literal like property", "'abc' LIKE pattern", "'abc' LIKE \"pattern\" ESCAPE '\\'"),
+		Entry("like escaped wildcards", "name LIKE 'a\\%b\\_c\\\\d%'", "\"name\" LIKE 'a\\%b\\_c\\\\d%' ESCAPE '\\'"),
+		Entry("like redundant escape", "name LIKE '\\a\\b'", "\"name\" LIKE 'ab' ESCAPE '\\'"),
+		Entry("like quote in pattern", "name LIKE 'O''Br%'", "\"name\" LIKE 'O''Br%' ESCAPE '\\'"),
 		Entry("property bwetween constants", "id BETWEEN 1 and 2", "\"id\" BETWEEN 1 AND 2"),
 		Entry("property not between constants", "id NOT BETWEEN 1 and 2", "\"id\" NOT BETWEEN 1 AND 2"),
 		Entry("in list", "id IN (1,2,3)", "\"id\" IN (1,2,3)"),
 		Entry("not in list", "id NOT IN (1,2,3)", "\"id\" NOT IN (1,2,3)"),
 		Entry("in list of strings", "id IN ('a','b','c')", "\"id\" IN ('a','b','c')"),
+		Entry("in list of dates and timestamps", "t IN (2020-01-01, 2020-01-02T10:00:00Z)", "\"t\" IN (date '2020-01-01',timestamp '2020-01-02T10:00:00Z')"),
+		Entry("in list of booleans", "flag NOT IN (TRUE, false)", "\"flag\" NOT IN (TRUE,false)"),
+		Entry("in list of properties", "id IN (a, b, 'c')", "\"id\" IN (\"a\",\"b\",'c')"),
+		Entry("in list of expressions", "id + 1 IN (x * 2, 3)", "\"id\" + 1 IN (\"x\" * 2,3)"),
+		Entry("constant in property list", "'a' IN (name, alias)", "'a' IN (\"name\",\"alias\")"),
 		Entry("is null", "id IS NULL", "\"id\" IS NULL"),
 		Entry("is not null", "id IS NOT NULL", "\"id\" IS NOT NULL"),
+		Entry("expression is null", "a + b IS NULL", "\"a\" + \"b\" IS NULL"),
+		Entry("function is null", "casei(name) IS NOT NULL", "lower(\"name\") IS NOT NULL"),
+		Entry("casei comparison", "CASEI(name) = casei('Bob')", "lower(\"name\") = lower('Bob')"),
 		Entry("spatial crosses function", "crosses(geom, POINT(0 0))", "ST_Crosses(\"geom\",'SRID=4326;POINT(0 0)'::geometry)"),
 		Entry("spatial contains function", "Contains(geom, POINT(0 0))", "ST_Contains(\"geom\",'SRID=4326;POINT(0 0)'::geometry)"),
 		Entry("spatial disjoint function", "DISJOINT(geom, POINT(0 0))", "ST_Disjoint(\"geom\",'SRID=4326;POINT(0 0)'::geometry)"),
@@ -58,6 +75,15 @@ var _ = Describe("CQL2", func() {
 		Entry("not boolean", "NOT TRUE OR FALSE", "NOT TRUE OR FALSE"),
 		Entry("not boolean lower-case", "NOT true OR false", "NOT true OR false"),
 		Entry("complex expression", "x = 1 OR NOT (x = 2 AND y < 4)", "\"x\" = 1 OR NOT (\"x\" = 2 AND \"y\" < 4)"),
+		Entry("boolean property", "is_active AND pop > 5", "\"is_active\" AND \"pop\" > 5"),
+		Entry("not boolean property", "NOT is_deleted", "NOT \"is_deleted\""),
+		Entry("boolean property is true", "flag IS TRUE", "\"flag\" IS TRUE"),
+		Entry("boolean property is not false", "flag IS NOT false", "\"flag\" IS NOT FALSE"),
+		Entry("boolean property is unknown", "flag IS UNKNOWN", "\"flag\" IS UNKNOWN"),
+		Entry("predicate is true", "x = 1 IS TRUE", "(\"x\" = 1) IS TRUE"),
+		Entry("predicate is not unknown", "x > y IS NOT UNKNOWN OR b", "(\"x\" > \"y\") IS NOT UNKNOWN OR \"b\""),
+		Entry("group is true", "(a = 1 OR b = 2) IS TRUE", "(\"a\" = 1 OR \"b\" = 2) IS TRUE"),
+		Entry("group is not false", "NOT (flag) IS NOT FALSE", "NOT (\"flag\") IS NOT FALSE"),
 	)
 
 	DescribeTable("arithematic",
@@ -107,6 +133,45 @@ var _ = Describe("CQL2", func() {
 			"ST_Equals(\"geom\",'SRID=4326;GEOMETRYCOLLECTION(POLYGON((1 4,4 1,1 1,1 4)),LINESTRING(3 3,5 5),POINT(1 5))'::geometry)"),
 		Entry("equals envelope", "equals(geom, ENVELOPE(1,2,3,4))",
 			"ST_Equals(\"geom\",ST_MakeEnvelope(1,2,3,4,4326))"),
+		Entry("equals 3D point", "equals(geom, POINT(1 2 3))",
+			"ST_Equals(\"geom\",'SRID=4326;POINT(1 2 3)'::geometry)"),
+		Entry("equals point Z", "equals(geom, POINT Z (1 2 3))",
+			"ST_Equals(\"geom\",'SRID=4326;POINT Z(1 2 3)'::geometry)"),
+		Entry("equals point M", "equals(geom, point m(1 2 3))",
+			"ST_Equals(\"geom\",'SRID=4326;POINT M(1 2 3)'::geometry)"),
+		Entry("equals linestring ZM", "equals(geom, LINESTRING ZM(0 0 1 2, 1 1 3 4))",
+			"ST_Equals(\"geom\",'SRID=4326;LINESTRING ZM(0 0 1 2,1 1 3 4)'::geometry)"),
+		Entry("equals polygon Z", "equals(geom, POLYGON Z((0 0 1, 0 9 1, 9 0 1, 0 0 1)))",
+			"ST_Equals(\"geom\",'SRID=4326;POLYGON Z((0 0 1,0 9 1,9 0 1,0 0 1))'::geometry)"),
+		Entry("equals multipoint Z", "equals(geom, MULTIPOINT Z((0 0 1), (0 9 2)))",
+			"ST_Equals(\"geom\",'SRID=4326;MULTIPOINT Z((0 0 1),(0 9 2))'::geometry)"),
+		Entry("equals 3D envelope", "equals(geom, ENVELOPE(1,2,3,4,5,6))",
+			"ST_Equals(\"geom\",ST_SetSRID(ST_3DMakeBox(ST_MakePoint(1,2,3),ST_MakePoint(4,5,6))::geometry,4326))"),
+		Entry("intersects empty point", "intersects(geom, POINT EMPTY)",
+			"ST_Intersects(\"geom\",'SRID=4326;POINT EMPTY'::geometry)"),
+		Entry("intersects empty polygon Z", "intersects(geom, polygon z empty)",
+			"ST_Intersects(\"geom\",'SRID=4326;POLYGON Z EMPTY'::geometry)"),
+		Entry("intersects empty geometrycollection", "intersects(geom, GEOMETRYCOLLECTION EMPTY)",
+			"ST_Intersects(\"geom\",'SRID=4326;GEOMETRYCOLLECTION EMPTY'::geometry)"),
+		Entry("geometrycollection with empty member", "intersects(geom, GEOMETRYCOLLECTION(POINT(1 2), LINESTRING EMPTY))",
+			"ST_Intersects(\"geom\",'SRID=4326;GEOMETRYCOLLECTION(POINT(1 2),LINESTRING EMPTY)'::geometry)"),
+		Entry("intersects circularstring", "intersects(geom, CIRCULARSTRING(0 0, 1 1, 1 0))",
+			"ST_Intersects(\"geom\",'SRID=4326;CIRCULARSTRING(0 0,1 1,1 0)'::geometry)"),
+		Entry("intersects compoundcurve", "intersects(geom, COMPOUNDCURVE(CIRCULARSTRING(0 0, 1 1, 1 0), (1 0, 0 1)))",
+			"ST_Intersects(\"geom\",'SRID=4326;COMPOUNDCURVE(CIRCULARSTRING(0 0,1 1,1 0),(1 0,0 1))'::geometry)"),
+		Entry("intersects curvepolygon", "intersects(geom, CURVEPOLYGON(CIRCULARSTRING(0 0, 4 0, 4 4, 0 4, 0 0), (1 1, 3 3, 3 1, 1 1)))",
+			"ST_Intersects(\"geom\",'SRID=4326;CURVEPOLYGON(CIRCULARSTRING(0 0,4 0,4 4,0 4,0 0),(1 1,3 3,3 1,1 1))'::geometry)"),
+		Entry("intersects multisurface", "intersects(geom, MULTISURFACE(CURVEPOLYGON(CIRCULARSTRING(0 0, 4 0, 4 4, 0 4, 0 0)), ((10 10, 14 12, 11 10, 10 10))))",
+			"ST_Intersects(\"geom\",'SRID=4326;MULTISURFACE(CURVEPOLYGON(CIRCULARSTRING(0 0,4 0,4 4,0 4,0 0)),((10 10,14 12,11 10,10 10)))'::geometry)"),
+		Entry("z and m remain property names", "z = 1 AND m = 2", "\"z\" = 1 AND \"m\" = 2"),
+		Entry("intersects multicurve", "intersects(geom, MULTICURVE((0 0, 1 1), CIRCULARSTRING(0 0, 1 1, 1 0)))",
+			"ST_Intersects(\"geom\",'SRID=4326;MULTICURVE((0 0,1 1),CIRCULARSTRING(0 0,1 1,1 0))'::geometry)"),
+		Entry("empty remains a property name", "empty = 1 AND circularstring > 2", "\"empty\" = 1 AND \"circularstring\" > 2"),
+		Entry("bbox remains a property name", "bbox = 1 AND intersects(bbox, BBOX(0,0,1,1))",
+			"\"bbox\" = 1 AND ST_Intersects(\"bbox\",ST_MakeEnvelope(0,0,1,1,4326))"),
+		Entry("unknown remains a property name", "unknown = 1 OR unknown IS UNKNOWN", "\"unknown\" = 1 OR \"unknown\" IS UNKNOWN"),
+		Entry("geometry keyword as geometry property", "intersects(multicurve, MULTICURVE EMPTY)",
+			"ST_Intersects(\"multicurve\",'SRID=4326;MULTICURVE EMPTY'::geometry)"),
 	)
 
 	DescribeTable("geometries with SRID",
@@ -121,6 +186,96 @@ var _ = Describe("CQL2", func() {
 			"ST_Equals(\"geom\",ST_Transform('SRID=1111;POINT(0 0)'::geometry,2222))"),
 		Entry("srid on envelope", "equals(geom, ENVELOPE(1,2,3,4))", 1111, 2222,
 			"ST_Equals(\"geom\",ST_Transform(ST_MakeEnvelope(1,2,3,4,1111),2222))"),
+		Entry("envelope crossing antimeridian", "intersects(geom, ENVELOPE(170,-10,-170,10))", 4326, 4326,
+			"ST_Intersects(\"geom\",ST_Collect(ST_MakeEnvelope(170,-10,180,10,4326),ST_MakeEnvelope(-180,-10,-170,10,4326)))"),
+		Entry("envelope crossing antimeridian with transform", "intersects(geom, ENVELOPE(170,-10,-170,10))", 4269, 3857,
+			"ST_Intersects(\"geom\",ST_Transform(ST_Collect(ST_MakeEnvelope(170,-10,180,10,4269),ST_MakeEnvelope(-180,-10,-170,10,4269)),3857))"),
+		Entry("inverted envelope in projected CRS", "intersects(geom, ENVELOPE(170,-10,-170,10))", 3857, 3857,
+			"ST_Intersects(\"geom\",ST_MakeEnvelope(170,-10,-170,10,3857))"),
+		Entry("3D envelope crossing antimeridian", "intersects(geom, ENVELOPE(170,-10,0,-170,10,100))", 4326, 4326,
+			"ST_Intersects(\"geom\",ST_Collect("+
+				"ST_SetSRID(ST_3DMakeBox(ST_MakePoint(170,-10,0),ST_MakePoint(180,10,100))::geometry,4326),"+
+				"ST_SetSRID(ST_3DMakeBox(ST_MakePoint(-180,-10,0),ST_MakePoint(-170,10,100))::geometry,4326)))"),
+	)
+
+	It("splits envelopes crossing the antimeridian in declared geographic CRSs", func() {
+		actual, err := cql2.TranspileToSQL("intersects(geom, ENVELOPE(170,-10,-170,10))", 4615, 4615, cql2.WithGeographicSRIDs(4615))
+		Expect(err).To(BeNil())
+		Expect(strings.TrimSpace(actual)).To(Equal(
+			"ST_Intersects(\"geom\",ST_Collect(ST_MakeEnvelope(170,-10,180,10,4615),ST_MakeEnvelope(-180,-10,-170,10,4615)))"))
+
+		actual, err = cql2.TranspileToSQL("intersects(geom, ENVELOPE(170,-10,-170,10))", 4615, 4615)
+		Expect(err).To(BeNil())
+		Expect(strings.TrimSpace(actual)).To(Equal("ST_Intersects(\"geom\",ST_MakeEnvelope(170,-10,-170,10,4615))"))
+	})
+
+	DescribeTable("registered functions",
+		func(cqlStr string, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326,
+				cql2.WithFunction("in_service", cql2.Function{SQLName: "app.in_service", NumArgs: 2, ResultType: "boolean"}),
+				cql2.WithFunction("Len", cql2.Function{SQLName: "char_length", NumArgs: 1, ResultType: "number"}))
+			Expect(err).To(BeNil())
+
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("boolean function term", "in_service(id, 2020-01-01)", "app.in_service(\"id\",date '2020-01-01')"),
+		Entry("not boolean function", "NOT IN_SERVICE(id, t) AND x = 1", "NOT app.in_service(\"id\",\"t\") AND \"x\" = 1"),
+		Entry("boolean function is not true", "in_service(id, t) IS NOT TRUE", "app.in_service(\"id\",\"t\") IS NOT TRUE"),
+		Entry("numeric function comparison", "len(name) > 3", "char_length(\"name\") > 3"),
+		Entry("builtin functions remain", "casei(name) = 'a'", "lower(\"name\") = 'a'"),
+	)
+
+	DescribeTable("throws type errors",
+		func(cqlStr string, column int) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326)
+			var typeErr *cql2.TypeError
+			Expect(errors.As(err, &typeErr)).To(BeTrue())
+			Expect(typeErr.Column).To(Equal(column))
+		},
+		Entry("in list of numbers and strings", "id IN (1, 'a')", 10),
+		Entry("in list of timestamps and numbers", "t IN (2020-01-01, 5)", 18),
+		Entry("string in list of numbers", "'a' IN (1, 2)", 8),
+		Entry("concatenation in list of numbers", "id IN (1, a || b)", 10),
+		Entry("like number", "id LIKE 5", 8),
+		Entry("like timestamp", "2020-01-01 LIKE '2020%'", 0),
+		Entry("like arithmetic", "a + 1 LIKE 'x'", 0),
+		Entry("string function as boolean term", "x = 1 AND casei(name)", 10),
+	)
+
+	DescribeTable("parameterized",
+		func(cqlStr string, sql string, params []interface{}) {
+			actual, actualParams, err := cql2.TranspileToParameterizedSQL(cqlStr, 4326, 4326, cql2.WithQueryables(cql2.Queryables{
+				"pop":     {Type: "integer"},
+				"founded": {Type: "string", Format: "date"},
+			}))
+			Expect(err).To(BeNil())
+			Expect(strings.TrimSpace(actual)).To(Equal(sql))
+			Expect(actualParams).To(Equal(params))
+		},
+		Entry("string", "name = 'O''Hara'", "\"name\" = $1", []interface{}{"O'Hara"}),
+		Entry("numbers are inline", "id = 1 AND name <> 'x'", "\"id\" = 1 AND \"name\" <> $1", []interface{}{"x"}),
+		Entry("timestamp", "t > TIMESTAMP('2020-01-01T00:00:00Z')", "\"t\" > $1::timestamp", []interface{}{"2020-01-01T00:00:00Z"}),
+		Entry("like pattern", "name LIKE 'a\\_%'", "\"name\" LIKE $1 ESCAPE '\\'", []interface{}{"a\\_%"}),
+		Entry("cast to declared type", "pop = '5' AND founded < '2000-01-01'",
+			"\"pop\" = $1::numeric AND \"founded\" < $2::date", []interface{}{"5", "2000-01-01"}),
+		Entry("in list", "name IN ('a', 'b')", "\"name\" IN ($1,$2)", []interface{}{"a", "b"}),
+	)
+
+	DescribeTable("reports syntax error positions",
+		func(cqlStr string, line int, column int) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326)
+			var syntaxErr *cql2.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+			Expect(syntaxErr.Line).To(Equal(line))
+			Expect(syntaxErr.Column).To(Equal(column))
+		},
+		Entry("missing operand", "x = ", 1, 4),
+		Entry("second line", "x = 1 AND\ny == 2", 2, 3),
+		Entry("unknown function", "x = 1 AND\n  foo(x) = 1", 2, 2),
+		Entry("wrong function arguments", "casei(x, y) = 1", 1, 0),
+		Entry("invalid geometry dimension", "equals(geom, POINT Q (0 0 0))", 1, 19),
+		Entry("LIKE pattern ending with an escape", "x = 1 AND\n  name LIKE 'a\\'", 2, 12),
 	)
 
 	DescribeTable("throws syntax errors",
@@ -134,6 +289,15 @@ var _ = Describe("CQL2", func() {
 		Entry("invalid expression", "NOT x IS > 3"),
 		Entry("extra paren", "equals(geom, ENVELOPE(1,2,3,4)))"),
 		Entry("comma between ordinates", "equals(geom, POINT(0,0))"),
+		Entry("invalid geometry dimension", "equals(geom, POINT Q (0 0 0))"),
+		Entry("too many ordinates", "equals(geom, POINT(0 0 0 0 0))"),
+		Entry("five value envelope", "equals(geom, ENVELOPE(1,2,3,4,5))"),
+		Entry("empty point with coordinates", "equals(geom, POINT EMPTY (0 0))"),
+		Entry("empty envelope", "equals(geom, ENVELOPE EMPTY)"),
+		Entry("empty in list", "id IN ()"),
+		Entry("unknown function", "foo(x) = 1"),
+		Entry("wrong function arguments", "casei(x, y) = 1"),
+		Entry("like pattern ending with escape", "name LIKE 'abc\\'"),
 		Entry("bad temporal value year", "p > 200-01"),
 		Entry("bad temporal value no day", "p > 2000-01"),
 		Entry("bad temporal values time missing minutes and seconds", "p > 2000-01-01T01"),
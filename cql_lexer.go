@@ -45,17 +45,20 @@ func cqllexerLexerInit() {
 	staticData.LiteralNames = []string{
 		"", "", "'<'", "'='", "'>'", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "'#'", "'$'", "'_'", "'\"'", "'%'", "'&'", "", "'('", "')'",
-		"'['", "']'", "'*'", "'+'", "','", "'-'", "'.'", "'/'", "'^'", "'||'",
-		"':'", "';'", "'?'", "'|'", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "''''",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "'#'", "'$'", "'_'",
+		"'\"'", "'%'", "'&'", "", "'('", "')'", "'['", "']'", "'*'", "'+'",
+		"','", "'-'", "'.'", "'/'", "'^'", "'||'", "':'", "';'", "'?'", "'|'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "''''",
 	}
 	staticData.SymbolicNames = []string{
 		"", "ComparisonOperator", "LT", "EQ", "GT", "NEQ", "GTEQ", "LTEQ", "BooleanLiteral",
 		"AND", "OR", "NOT", "LIKE", "ILIKE", "BETWEEN", "IS", "NULL", "IN",
-		"ArithmeticOperator", "SpatialOperator", "DistanceOperator", "POINT",
-		"LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON",
-		"GEOMETRYCOLLECTION", "ENVELOPE", "NumericLiteral", "Identifier", "IdentifierStart",
+		"UNKNOWN", "ArithmeticOperator", "SpatialOperator", "DistanceOperator",
+		"POINT", "LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON",
+		"GEOMETRYCOLLECTION", "ENVELOPE", "CIRCULARSTRING", "COMPOUNDCURVE",
+		"CURVEPOLYGON", "MULTICURVE", "MULTISURFACE", "EMPTY", "NumericLiteral",
+		"EwktSridPrefix", "WkbHexLiteral", "GeoJsonLiteral", "Identifier", "IdentifierStart",
 		"IdentifierPart", "ALPHA", "DIGIT", "OCTOTHORP", "DOLLAR", "UNDERSCORE",
 		"DOUBLEQUOTE", "PERCENT", "AMPERSAND", "QUOTE", "LEFTPAREN", "RIGHTPAREN",
 		"LEFTSQUAREBRACKET", "RIGHTSQUAREBRACKET", "ASTERISK", "PLUS", "COMMA",
@@ -71,25 +74,27 @@ func cqllexerLexerInit() {
 		"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N",
 		"O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z", "ComparisonOperator",
 		"LT", "EQ", "GT", "NEQ", "GTEQ", "LTEQ", "BooleanLiteral", "AND", "OR",
-		"NOT", "LIKE", "ILIKE", "BETWEEN", "IS", "NULL", "IN", "ArithmeticOperator",
+		"NOT", "LIKE", "ILIKE", "BETWEEN", "IS", "NULL", "IN", "UNKNOWN", "ArithmeticOperator",
 		"SpatialOperator", "DistanceOperator", "POINT", "LINESTRING", "POLYGON",
 		"MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON", "GEOMETRYCOLLECTION",
-		"ENVELOPE", "NumericLiteral", "CharacterStringLiteralStart", "Identifier",
-		"IdentifierStart", "IdentifierPart", "ALPHA", "DIGIT", "OCTOTHORP",
-		"DOLLAR", "UNDERSCORE", "DOUBLEQUOTE", "PERCENT", "AMPERSAND", "QUOTE",
-		"LEFTPAREN", "RIGHTPAREN", "LEFTSQUAREBRACKET", "RIGHTSQUAREBRACKET",
-		"ASTERISK", "PLUS", "COMMA", "MINUS", "PERIOD", "SOLIDUS", "CARET",
-		"CONCAT", "COLON", "SEMICOLON", "QUESTIONMARK", "VERTICALBAR", "BIT",
-		"HEXIT", "UnsignedNumericLiteral", "SignedNumericLiteral", "ExactNumericLiteral",
-		"ApproximateNumericLiteral", "Mantissa", "Exponent", "SignedInteger",
-		"UnsignedInteger", "Sign", "TemporalLiteral", "Instant", "FullDate",
-		"DateYear", "DateMonth", "DateDay", "UtcTime", "TimeZoneOffset", "TimeHour",
-		"TimeMinute", "TimeSecond", "NOW", "WS", "CharacterStringLiteral", "QuotedQuote",
-		"Character",
+		"ENVELOPE", "CIRCULARSTRING", "COMPOUNDCURVE", "CURVEPOLYGON", "MULTICURVE",
+		"MULTISURFACE", "EMPTY", "NumericLiteral", "CharacterStringLiteralStart",
+		"EwktSridPrefix", "WkbHexLiteral", "GeoJsonLiteral", "HexPair", "HexDigit",
+		"JsonString", "Identifier", "IdentifierStart", "IdentifierPart", "ALPHA",
+		"DIGIT", "OCTOTHORP", "DOLLAR", "UNDERSCORE", "DOUBLEQUOTE", "PERCENT",
+		"AMPERSAND", "QUOTE", "LEFTPAREN", "RIGHTPAREN", "LEFTSQUAREBRACKET",
+		"RIGHTSQUAREBRACKET", "ASTERISK", "PLUS", "COMMA", "MINUS", "PERIOD",
+		"SOLIDUS", "CARET", "CONCAT", "COLON", "SEMICOLON", "QUESTIONMARK",
+		"VERTICALBAR", "BIT", "HEXIT", "UnsignedNumericLiteral", "SignedNumericLiteral",
+		"ExactNumericLiteral", "ApproximateNumericLiteral", "Mantissa", "Exponent",
+		"SignedInteger", "UnsignedInteger", "Sign", "TemporalLiteral", "Instant",
+		"FullDate", "DateYear", "DateMonth", "DateDay", "UtcTime", "TimeZoneOffset",
+		"TimeHour", "TimeMinute", "TimeSecond", "NOW", "WS", "CharacterStringLiteral",
+		"QuotedQuote", "Character",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 83, 758, 6, -1, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3,
+		4, 0, 93, 999, 6, -1, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3,
 		7, 3, 2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9,
 		7, 9, 2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7,
 		14, 2, 15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19,
@@ -110,327 +115,440 @@ func cqllexerLexerInit() {
 		7, 93, 2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7,
 		98, 2, 99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103,
 		7, 103, 2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107,
-		2, 108, 7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 1, 0, 1, 0, 1, 1, 1, 1,
-		1, 2, 1, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7,
-		1, 8, 1, 8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13,
-		1, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1,
-		18, 1, 19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 23, 1, 23,
-		1, 24, 1, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 3,
-		26, 283, 8, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30,
-		1, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 311, 8, 33,
-		1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1,
-		36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1,
-		40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43,
-		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 361, 8, 43, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
-		1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
-		1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
-		1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 3, 44, 431, 8, 44, 1, 45, 1, 45, 1, 45, 1, 45,
-		1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1,
-		47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
-		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1,
-		49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50,
+		2, 108, 7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112,
+		7, 112, 2, 113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116,
+		2, 117, 7, 117, 2, 118, 7, 118, 2, 119, 7, 119, 2, 120, 7, 120, 2, 121,
+		7, 121, 2, 122, 7, 122, 2, 123, 7, 123, 1, 0, 1, 0, 1, 1, 1, 1, 1, 2, 1,
+		2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 6, 1, 7, 1, 7, 1, 8, 1,
+		8, 1, 9, 1, 9, 1, 10, 1, 10, 1, 11, 1, 11, 1, 12, 1, 12, 1, 13, 1, 13,
+		1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18, 1,
+		19, 1, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 23, 1, 23, 1, 24,
+		1, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 309,
+		8, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1,
+		31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 337, 8, 33, 1, 34, 1,
+		34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37,
+		1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1,
+		39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40,
+		1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1,
+		43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44,
+		1, 44, 1, 44, 3, 44, 395, 8, 44, 1, 45, 1, 45, 1, 45, 3, 45, 400, 8, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 3, 45, 470, 8, 45, 1, 46, 1, 46, 1,
+		46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
+		1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50,
 		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
-		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
-		1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1,
-		53, 1, 53, 1, 53, 1, 54, 1, 54, 3, 54, 536, 8, 54, 1, 55, 1, 55, 1, 55,
-		1, 55, 1, 55, 1, 56, 1, 56, 5, 56, 545, 8, 56, 10, 56, 12, 56, 548, 9,
-		56, 1, 56, 1, 56, 1, 56, 1, 56, 3, 56, 554, 8, 56, 1, 57, 1, 57, 1, 58,
-		1, 58, 1, 58, 1, 58, 3, 58, 562, 8, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1,
-		61, 1, 61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66,
-		1, 66, 1, 67, 1, 67, 1, 68, 1, 68, 1, 69, 1, 69, 1, 70, 1, 70, 1, 71, 1,
-		71, 1, 72, 1, 72, 1, 73, 1, 73, 1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76,
-		1, 77, 1, 77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 81, 1,
-		81, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85,
-		1, 85, 1, 85, 1, 85, 3, 85, 624, 8, 85, 1, 86, 1, 86, 3, 86, 628, 8, 86,
-		1, 87, 3, 87, 631, 8, 87, 1, 87, 1, 87, 3, 87, 635, 8, 87, 1, 88, 1, 88,
-		1, 88, 3, 88, 640, 8, 88, 3, 88, 642, 8, 88, 1, 88, 1, 88, 1, 88, 3, 88,
-		647, 8, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1,
-		92, 3, 92, 658, 8, 92, 1, 92, 1, 92, 1, 93, 4, 93, 663, 8, 93, 11, 93,
-		12, 93, 664, 1, 94, 1, 94, 3, 94, 669, 8, 94, 1, 95, 1, 95, 1, 96, 1, 96,
-		1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 1, 96, 3, 96, 682, 8, 96, 1,
-		97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98,
-		1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 1,
-		101, 1, 101, 3, 101, 706, 8, 101, 1, 101, 3, 101, 709, 8, 101, 1, 102,
-		1, 102, 1, 102, 1, 102, 1, 102, 1, 102, 3, 102, 717, 8, 102, 1, 103, 1,
-		103, 1, 103, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1, 105, 1, 105, 4,
-		105, 729, 8, 105, 11, 105, 12, 105, 730, 3, 105, 733, 8, 105, 1, 106, 1,
-		106, 1, 106, 1, 106, 1, 107, 4, 107, 740, 8, 107, 11, 107, 12, 107, 741,
-		1, 107, 1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 109, 1, 109,
-		1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 110, 0, 0, 111, 2, 0, 4, 0,
-		6, 0, 8, 0, 10, 0, 12, 0, 14, 0, 16, 0, 18, 0, 20, 0, 22, 0, 24, 0, 26,
-		0, 28, 0, 30, 0, 32, 0, 34, 0, 36, 0, 38, 0, 40, 0, 42, 0, 44, 0, 46, 0,
-		48, 0, 50, 0, 52, 0, 54, 1, 56, 2, 58, 3, 60, 4, 62, 5, 64, 6, 66, 7, 68,
-		8, 70, 9, 72, 10, 74, 11, 76, 12, 78, 13, 80, 14, 82, 15, 84, 16, 86, 17,
-		88, 18, 90, 19, 92, 20, 94, 21, 96, 22, 98, 23, 100, 24, 102, 25, 104,
-		26, 106, 27, 108, 28, 110, 29, 112, 0, 114, 30, 116, 31, 118, 32, 120,
-		33, 122, 34, 124, 35, 126, 36, 128, 37, 130, 38, 132, 39, 134, 40, 136,
-		41, 138, 42, 140, 43, 142, 44, 144, 45, 146, 46, 148, 47, 150, 48, 152,
-		49, 154, 50, 156, 51, 158, 52, 160, 53, 162, 54, 164, 55, 166, 56, 168,
-		57, 170, 58, 172, 59, 174, 60, 176, 61, 178, 62, 180, 63, 182, 64, 184,
-		65, 186, 66, 188, 67, 190, 68, 192, 69, 194, 70, 196, 71, 198, 72, 200,
-		73, 202, 74, 204, 75, 206, 76, 208, 77, 210, 78, 212, 79, 214, 80, 216,
-		81, 218, 82, 220, 83, 222, 0, 2, 0, 1, 30, 2, 0, 65, 65, 97, 97, 2, 0,
-		66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 100, 2, 0, 69,
-		69, 101, 101, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2, 0, 72,
-		72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2, 0, 75,
-		75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2, 0, 78,
-		78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2, 0, 81,
-		81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2, 0, 84,
-		84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2, 0, 87,
-		87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2, 0, 90,
-		90, 122, 122, 2, 0, 65, 90, 97, 122, 1, 0, 48, 57, 3, 0, 9, 10, 13, 13,
-		32, 32, 1, 0, 39, 39, 778, 0, 54, 1, 0, 0, 0, 0, 56, 1, 0, 0, 0, 0, 58,
-		1, 0, 0, 0, 0, 60, 1, 0, 0, 0, 0, 62, 1, 0, 0, 0, 0, 64, 1, 0, 0, 0, 0,
-		66, 1, 0, 0, 0, 0, 68, 1, 0, 0, 0, 0, 70, 1, 0, 0, 0, 0, 72, 1, 0, 0, 0,
-		0, 74, 1, 0, 0, 0, 0, 76, 1, 0, 0, 0, 0, 78, 1, 0, 0, 0, 0, 80, 1, 0, 0,
-		0, 0, 82, 1, 0, 0, 0, 0, 84, 1, 0, 0, 0, 0, 86, 1, 0, 0, 0, 0, 88, 1, 0,
-		0, 0, 0, 90, 1, 0, 0, 0, 0, 92, 1, 0, 0, 0, 0, 94, 1, 0, 0, 0, 0, 96, 1,
-		0, 0, 0, 0, 98, 1, 0, 0, 0, 0, 100, 1, 0, 0, 0, 0, 102, 1, 0, 0, 0, 0,
-		104, 1, 0, 0, 0, 0, 106, 1, 0, 0, 0, 0, 108, 1, 0, 0, 0, 0, 110, 1, 0,
-		0, 0, 0, 112, 1, 0, 0, 0, 0, 114, 1, 0, 0, 0, 0, 116, 1, 0, 0, 0, 0, 118,
-		1, 0, 0, 0, 0, 120, 1, 0, 0, 0, 0, 122, 1, 0, 0, 0, 0, 124, 1, 0, 0, 0,
-		0, 126, 1, 0, 0, 0, 0, 128, 1, 0, 0, 0, 0, 130, 1, 0, 0, 0, 0, 132, 1,
-		0, 0, 0, 0, 134, 1, 0, 0, 0, 0, 136, 1, 0, 0, 0, 0, 138, 1, 0, 0, 0, 0,
-		140, 1, 0, 0, 0, 0, 142, 1, 0, 0, 0, 0, 144, 1, 0, 0, 0, 0, 146, 1, 0,
-		0, 0, 0, 148, 1, 0, 0, 0, 0, 150, 1, 0, 0, 0, 0, 152, 1, 0, 0, 0, 0, 154,
-		1, 0, 0, 0, 0, 156, 1, 0, 0, 0, 0, 158, 1, 0, 0, 0, 0, 160, 1, 0, 0, 0,
-		0, 162, 1, 0, 0, 0, 0, 164, 1, 0, 0, 0, 0, 166, 1, 0, 0, 0, 0, 168, 1,
-		0, 0, 0, 0, 170, 1, 0, 0, 0, 0, 172, 1, 0, 0, 0, 0, 174, 1, 0, 0, 0, 0,
-		176, 1, 0, 0, 0, 0, 178, 1, 0, 0, 0, 0, 180, 1, 0, 0, 0, 0, 182, 1, 0,
-		0, 0, 0, 184, 1, 0, 0, 0, 0, 186, 1, 0, 0, 0, 0, 188, 1, 0, 0, 0, 0, 190,
-		1, 0, 0, 0, 0, 192, 1, 0, 0, 0, 0, 194, 1, 0, 0, 0, 0, 196, 1, 0, 0, 0,
-		0, 198, 1, 0, 0, 0, 0, 200, 1, 0, 0, 0, 0, 202, 1, 0, 0, 0, 0, 204, 1,
-		0, 0, 0, 0, 206, 1, 0, 0, 0, 0, 208, 1, 0, 0, 0, 0, 210, 1, 0, 0, 0, 0,
-		212, 1, 0, 0, 0, 0, 214, 1, 0, 0, 0, 0, 216, 1, 0, 0, 0, 1, 218, 1, 0,
-		0, 0, 1, 220, 1, 0, 0, 0, 1, 222, 1, 0, 0, 0, 2, 224, 1, 0, 0, 0, 4, 226,
-		1, 0, 0, 0, 6, 228, 1, 0, 0, 0, 8, 230, 1, 0, 0, 0, 10, 232, 1, 0, 0, 0,
-		12, 234, 1, 0, 0, 0, 14, 236, 1, 0, 0, 0, 16, 238, 1, 0, 0, 0, 18, 240,
-		1, 0, 0, 0, 20, 242, 1, 0, 0, 0, 22, 244, 1, 0, 0, 0, 24, 246, 1, 0, 0,
-		0, 26, 248, 1, 0, 0, 0, 28, 250, 1, 0, 0, 0, 30, 252, 1, 0, 0, 0, 32, 254,
-		1, 0, 0, 0, 34, 256, 1, 0, 0, 0, 36, 258, 1, 0, 0, 0, 38, 260, 1, 0, 0,
-		0, 40, 262, 1, 0, 0, 0, 42, 264, 1, 0, 0, 0, 44, 266, 1, 0, 0, 0, 46, 268,
-		1, 0, 0, 0, 48, 270, 1, 0, 0, 0, 50, 272, 1, 0, 0, 0, 52, 274, 1, 0, 0,
-		0, 54, 282, 1, 0, 0, 0, 56, 284, 1, 0, 0, 0, 58, 286, 1, 0, 0, 0, 60, 288,
-		1, 0, 0, 0, 62, 290, 1, 0, 0, 0, 64, 293, 1, 0, 0, 0, 66, 296, 1, 0, 0,
-		0, 68, 310, 1, 0, 0, 0, 70, 312, 1, 0, 0, 0, 72, 316, 1, 0, 0, 0, 74, 319,
-		1, 0, 0, 0, 76, 323, 1, 0, 0, 0, 78, 328, 1, 0, 0, 0, 80, 334, 1, 0, 0,
-		0, 82, 342, 1, 0, 0, 0, 84, 345, 1, 0, 0, 0, 86, 350, 1, 0, 0, 0, 88, 360,
-		1, 0, 0, 0, 90, 430, 1, 0, 0, 0, 92, 432, 1, 0, 0, 0, 94, 440, 1, 0, 0,
-		0, 96, 446, 1, 0, 0, 0, 98, 457, 1, 0, 0, 0, 100, 465, 1, 0, 0, 0, 102,
-		476, 1, 0, 0, 0, 104, 492, 1, 0, 0, 0, 106, 505, 1, 0, 0, 0, 108, 524,
-		1, 0, 0, 0, 110, 535, 1, 0, 0, 0, 112, 537, 1, 0, 0, 0, 114, 553, 1, 0,
-		0, 0, 116, 555, 1, 0, 0, 0, 118, 561, 1, 0, 0, 0, 120, 563, 1, 0, 0, 0,
-		122, 565, 1, 0, 0, 0, 124, 567, 1, 0, 0, 0, 126, 569, 1, 0, 0, 0, 128,
-		571, 1, 0, 0, 0, 130, 573, 1, 0, 0, 0, 132, 575, 1, 0, 0, 0, 134, 577,
-		1, 0, 0, 0, 136, 579, 1, 0, 0, 0, 138, 581, 1, 0, 0, 0, 140, 583, 1, 0,
-		0, 0, 142, 585, 1, 0, 0, 0, 144, 587, 1, 0, 0, 0, 146, 589, 1, 0, 0, 0,
-		148, 591, 1, 0, 0, 0, 150, 593, 1, 0, 0, 0, 152, 595, 1, 0, 0, 0, 154,
-		597, 1, 0, 0, 0, 156, 599, 1, 0, 0, 0, 158, 601, 1, 0, 0, 0, 160, 603,
-		1, 0, 0, 0, 162, 606, 1, 0, 0, 0, 164, 608, 1, 0, 0, 0, 166, 610, 1, 0,
-		0, 0, 168, 612, 1, 0, 0, 0, 170, 614, 1, 0, 0, 0, 172, 623, 1, 0, 0, 0,
-		174, 627, 1, 0, 0, 0, 176, 634, 1, 0, 0, 0, 178, 646, 1, 0, 0, 0, 180,
-		648, 1, 0, 0, 0, 182, 652, 1, 0, 0, 0, 184, 654, 1, 0, 0, 0, 186, 657,
-		1, 0, 0, 0, 188, 662, 1, 0, 0, 0, 190, 668, 1, 0, 0, 0, 192, 670, 1, 0,
-		0, 0, 194, 681, 1, 0, 0, 0, 196, 683, 1, 0, 0, 0, 198, 689, 1, 0, 0, 0,
-		200, 694, 1, 0, 0, 0, 202, 697, 1, 0, 0, 0, 204, 700, 1, 0, 0, 0, 206,
-		716, 1, 0, 0, 0, 208, 718, 1, 0, 0, 0, 210, 721, 1, 0, 0, 0, 212, 724,
-		1, 0, 0, 0, 214, 734, 1, 0, 0, 0, 216, 739, 1, 0, 0, 0, 218, 745, 1, 0,
-		0, 0, 220, 749, 1, 0, 0, 0, 222, 754, 1, 0, 0, 0, 224, 225, 7, 0, 0, 0,
-		225, 3, 1, 0, 0, 0, 226, 227, 7, 1, 0, 0, 227, 5, 1, 0, 0, 0, 228, 229,
-		7, 2, 0, 0, 229, 7, 1, 0, 0, 0, 230, 231, 7, 3, 0, 0, 231, 9, 1, 0, 0,
-		0, 232, 233, 7, 4, 0, 0, 233, 11, 1, 0, 0, 0, 234, 235, 7, 5, 0, 0, 235,
-		13, 1, 0, 0, 0, 236, 237, 7, 6, 0, 0, 237, 15, 1, 0, 0, 0, 238, 239, 7,
-		7, 0, 0, 239, 17, 1, 0, 0, 0, 240, 241, 7, 8, 0, 0, 241, 19, 1, 0, 0, 0,
-		242, 243, 7, 9, 0, 0, 243, 21, 1, 0, 0, 0, 244, 245, 7, 10, 0, 0, 245,
-		23, 1, 0, 0, 0, 246, 247, 7, 11, 0, 0, 247, 25, 1, 0, 0, 0, 248, 249, 7,
-		12, 0, 0, 249, 27, 1, 0, 0, 0, 250, 251, 7, 13, 0, 0, 251, 29, 1, 0, 0,
-		0, 252, 253, 7, 14, 0, 0, 253, 31, 1, 0, 0, 0, 254, 255, 7, 15, 0, 0, 255,
-		33, 1, 0, 0, 0, 256, 257, 7, 16, 0, 0, 257, 35, 1, 0, 0, 0, 258, 259, 7,
-		17, 0, 0, 259, 37, 1, 0, 0, 0, 260, 261, 7, 18, 0, 0, 261, 39, 1, 0, 0,
-		0, 262, 263, 7, 19, 0, 0, 263, 41, 1, 0, 0, 0, 264, 265, 7, 20, 0, 0, 265,
-		43, 1, 0, 0, 0, 266, 267, 7, 21, 0, 0, 267, 45, 1, 0, 0, 0, 268, 269, 7,
-		22, 0, 0, 269, 47, 1, 0, 0, 0, 270, 271, 7, 23, 0, 0, 271, 49, 1, 0, 0,
-		0, 272, 273, 7, 24, 0, 0, 273, 51, 1, 0, 0, 0, 274, 275, 7, 25, 0, 0, 275,
-		53, 1, 0, 0, 0, 276, 283, 3, 58, 28, 0, 277, 283, 3, 62, 30, 0, 278, 283,
-		3, 56, 27, 0, 279, 283, 3, 60, 29, 0, 280, 283, 3, 66, 32, 0, 281, 283,
-		3, 64, 31, 0, 282, 276, 1, 0, 0, 0, 282, 277, 1, 0, 0, 0, 282, 278, 1,
-		0, 0, 0, 282, 279, 1, 0, 0, 0, 282, 280, 1, 0, 0, 0, 282, 281, 1, 0, 0,
-		0, 283, 55, 1, 0, 0, 0, 284, 285, 5, 60, 0, 0, 285, 57, 1, 0, 0, 0, 286,
-		287, 5, 61, 0, 0, 287, 59, 1, 0, 0, 0, 288, 289, 5, 62, 0, 0, 289, 61,
-		1, 0, 0, 0, 290, 291, 3, 56, 27, 0, 291, 292, 3, 60, 29, 0, 292, 63, 1,
-		0, 0, 0, 293, 294, 3, 60, 29, 0, 294, 295, 3, 58, 28, 0, 295, 65, 1, 0,
-		0, 0, 296, 297, 3, 56, 27, 0, 297, 298, 3, 58, 28, 0, 298, 67, 1, 0, 0,
-		0, 299, 300, 3, 40, 19, 0, 300, 301, 3, 36, 17, 0, 301, 302, 3, 42, 20,
-		0, 302, 303, 3, 10, 4, 0, 303, 311, 1, 0, 0, 0, 304, 305, 3, 12, 5, 0,
-		305, 306, 3, 2, 0, 0, 306, 307, 3, 24, 11, 0, 307, 308, 3, 38, 18, 0, 308,
-		309, 3, 10, 4, 0, 309, 311, 1, 0, 0, 0, 310, 299, 1, 0, 0, 0, 310, 304,
-		1, 0, 0, 0, 311, 69, 1, 0, 0, 0, 312, 313, 3, 2, 0, 0, 313, 314, 3, 28,
-		13, 0, 314, 315, 3, 8, 3, 0, 315, 71, 1, 0, 0, 0, 316, 317, 3, 30, 14,
-		0, 317, 318, 3, 36, 17, 0, 318, 73, 1, 0, 0, 0, 319, 320, 3, 28, 13, 0,
-		320, 321, 3, 30, 14, 0, 321, 322, 3, 40, 19, 0, 322, 75, 1, 0, 0, 0, 323,
-		324, 3, 24, 11, 0, 324, 325, 3, 18, 8, 0, 325, 326, 3, 22, 10, 0, 326,
-		327, 3, 10, 4, 0, 327, 77, 1, 0, 0, 0, 328, 329, 3, 18, 8, 0, 329, 330,
-		3, 24, 11, 0, 330, 331, 3, 18, 8, 0, 331, 332, 3, 22, 10, 0, 332, 333,
-		3, 10, 4, 0, 333, 79, 1, 0, 0, 0, 334, 335, 3, 4, 1, 0, 335, 336, 3, 10,
-		4, 0, 336, 337, 3, 40, 19, 0, 337, 338, 3, 46, 22, 0, 338, 339, 3, 10,
-		4, 0, 339, 340, 3, 10, 4, 0, 340, 341, 3, 28, 13, 0, 341, 81, 1, 0, 0,
-		0, 342, 343, 3, 18, 8, 0, 343, 344, 3, 38, 18, 0, 344, 83, 1, 0, 0, 0,
-		345, 346, 3, 28, 13, 0, 346, 347, 3, 42, 20, 0, 347, 348, 3, 24, 11, 0,
-		348, 349, 3, 24, 11, 0, 349, 85, 1, 0, 0, 0, 350, 351, 3, 18, 8, 0, 351,
-		352, 3, 28, 13, 0, 352, 87, 1, 0, 0, 0, 353, 361, 3, 148, 73, 0, 354, 361,
-		3, 152, 75, 0, 355, 361, 3, 146, 72, 0, 356, 361, 3, 156, 77, 0, 357, 361,
-		3, 132, 65, 0, 358, 361, 3, 158, 78, 0, 359, 361, 3, 160, 79, 0, 360, 353,
-		1, 0, 0, 0, 360, 354, 1, 0, 0, 0, 360, 355, 1, 0, 0, 0, 360, 356, 1, 0,
-		0, 0, 360, 357, 1, 0, 0, 0, 360, 358, 1, 0, 0, 0, 360, 359, 1, 0, 0, 0,
-		361, 89, 1, 0, 0, 0, 362, 363, 3, 10, 4, 0, 363, 364, 3, 34, 16, 0, 364,
-		365, 3, 42, 20, 0, 365, 366, 3, 2, 0, 0, 366, 367, 3, 24, 11, 0, 367, 368,
-		3, 38, 18, 0, 368, 431, 1, 0, 0, 0, 369, 370, 3, 8, 3, 0, 370, 371, 3,
-		18, 8, 0, 371, 372, 3, 38, 18, 0, 372, 373, 3, 20, 9, 0, 373, 374, 3, 30,
-		14, 0, 374, 375, 3, 18, 8, 0, 375, 376, 3, 28, 13, 0, 376, 377, 3, 40,
-		19, 0, 377, 431, 1, 0, 0, 0, 378, 379, 3, 40, 19, 0, 379, 380, 3, 30, 14,
-		0, 380, 381, 3, 42, 20, 0, 381, 382, 3, 6, 2, 0, 382, 383, 3, 16, 7, 0,
-		383, 384, 3, 10, 4, 0, 384, 385, 3, 38, 18, 0, 385, 431, 1, 0, 0, 0, 386,
-		387, 3, 46, 22, 0, 387, 388, 3, 18, 8, 0, 388, 389, 3, 40, 19, 0, 389,
-		390, 3, 16, 7, 0, 390, 391, 3, 18, 8, 0, 391, 392, 3, 28, 13, 0, 392, 431,
-		1, 0, 0, 0, 393, 394, 3, 30, 14, 0, 394, 395, 3, 44, 21, 0, 395, 396, 3,
-		10, 4, 0, 396, 397, 3, 36, 17, 0, 397, 398, 3, 24, 11, 0, 398, 399, 3,
-		2, 0, 0, 399, 400, 3, 32, 15, 0, 400, 401, 3, 38, 18, 0, 401, 431, 1, 0,
-		0, 0, 402, 403, 3, 6, 2, 0, 403, 404, 3, 36, 17, 0, 404, 405, 3, 30, 14,
-		0, 405, 406, 3, 38, 18, 0, 406, 407, 3, 38, 18, 0, 407, 408, 3, 10, 4,
-		0, 408, 409, 3, 38, 18, 0, 409, 431, 1, 0, 0, 0, 410, 411, 3, 18, 8, 0,
-		411, 412, 3, 28, 13, 0, 412, 413, 3, 40, 19, 0, 413, 414, 3, 10, 4, 0,
-		414, 415, 3, 36, 17, 0, 415, 416, 3, 38, 18, 0, 416, 417, 3, 10, 4, 0,
-		417, 418, 3, 6, 2, 0, 418, 419, 3, 40, 19, 0, 419, 420, 3, 38, 18, 0, 420,
-		431, 1, 0, 0, 0, 421, 422, 3, 6, 2, 0, 422, 423, 3, 30, 14, 0, 423, 424,
-		3, 28, 13, 0, 424, 425, 3, 40, 19, 0, 425, 426, 3, 2, 0, 0, 426, 427, 3,
-		18, 8, 0, 427, 428, 3, 28, 13, 0, 428, 429, 3, 38, 18, 0, 429, 431, 1,
-		0, 0, 0, 430, 362, 1, 0, 0, 0, 430, 369, 1, 0, 0, 0, 430, 378, 1, 0, 0,
-		0, 430, 386, 1, 0, 0, 0, 430, 393, 1, 0, 0, 0, 430, 402, 1, 0, 0, 0, 430,
-		410, 1, 0, 0, 0, 430, 421, 1, 0, 0, 0, 431, 91, 1, 0, 0, 0, 432, 433, 3,
-		8, 3, 0, 433, 434, 3, 46, 22, 0, 434, 435, 3, 18, 8, 0, 435, 436, 3, 40,
-		19, 0, 436, 437, 3, 16, 7, 0, 437, 438, 3, 18, 8, 0, 438, 439, 3, 28, 13,
-		0, 439, 93, 1, 0, 0, 0, 440, 441, 3, 32, 15, 0, 441, 442, 3, 30, 14, 0,
-		442, 443, 3, 18, 8, 0, 443, 444, 3, 28, 13, 0, 444, 445, 3, 40, 19, 0,
-		445, 95, 1, 0, 0, 0, 446, 447, 3, 24, 11, 0, 447, 448, 3, 18, 8, 0, 448,
-		449, 3, 28, 13, 0, 449, 450, 3, 10, 4, 0, 450, 451, 3, 38, 18, 0, 451,
-		452, 3, 40, 19, 0, 452, 453, 3, 36, 17, 0, 453, 454, 3, 18, 8, 0, 454,
-		455, 3, 28, 13, 0, 455, 456, 3, 14, 6, 0, 456, 97, 1, 0, 0, 0, 457, 458,
-		3, 32, 15, 0, 458, 459, 3, 30, 14, 0, 459, 460, 3, 24, 11, 0, 460, 461,
-		3, 50, 24, 0, 461, 462, 3, 14, 6, 0, 462, 463, 3, 30, 14, 0, 463, 464,
-		3, 28, 13, 0, 464, 99, 1, 0, 0, 0, 465, 466, 3, 26, 12, 0, 466, 467, 3,
-		42, 20, 0, 467, 468, 3, 24, 11, 0, 468, 469, 3, 40, 19, 0, 469, 470, 3,
-		18, 8, 0, 470, 471, 3, 32, 15, 0, 471, 472, 3, 30, 14, 0, 472, 473, 3,
-		18, 8, 0, 473, 474, 3, 28, 13, 0, 474, 475, 3, 40, 19, 0, 475, 101, 1,
-		0, 0, 0, 476, 477, 3, 26, 12, 0, 477, 478, 3, 42, 20, 0, 478, 479, 3, 24,
-		11, 0, 479, 480, 3, 40, 19, 0, 480, 481, 3, 18, 8, 0, 481, 482, 3, 24,
-		11, 0, 482, 483, 3, 18, 8, 0, 483, 484, 3, 28, 13, 0, 484, 485, 3, 10,
-		4, 0, 485, 486, 3, 38, 18, 0, 486, 487, 3, 40, 19, 0, 487, 488, 3, 36,
-		17, 0, 488, 489, 3, 18, 8, 0, 489, 490, 3, 28, 13, 0, 490, 491, 3, 14,
-		6, 0, 491, 103, 1, 0, 0, 0, 492, 493, 3, 26, 12, 0, 493, 494, 3, 42, 20,
-		0, 494, 495, 3, 24, 11, 0, 495, 496, 3, 40, 19, 0, 496, 497, 3, 18, 8,
-		0, 497, 498, 3, 32, 15, 0, 498, 499, 3, 30, 14, 0, 499, 500, 3, 24, 11,
-		0, 500, 501, 3, 50, 24, 0, 501, 502, 3, 14, 6, 0, 502, 503, 3, 30, 14,
-		0, 503, 504, 3, 28, 13, 0, 504, 105, 1, 0, 0, 0, 505, 506, 3, 14, 6, 0,
-		506, 507, 3, 10, 4, 0, 507, 508, 3, 30, 14, 0, 508, 509, 3, 26, 12, 0,
-		509, 510, 3, 10, 4, 0, 510, 511, 3, 40, 19, 0, 511, 512, 3, 36, 17, 0,
-		512, 513, 3, 50, 24, 0, 513, 514, 3, 6, 2, 0, 514, 515, 3, 30, 14, 0, 515,
-		516, 3, 24, 11, 0, 516, 517, 3, 24, 11, 0, 517, 518, 3, 10, 4, 0, 518,
-		519, 3, 6, 2, 0, 519, 520, 3, 40, 19, 0, 520, 521, 3, 18, 8, 0, 521, 522,
-		3, 30, 14, 0, 522, 523, 3, 28, 13, 0, 523, 107, 1, 0, 0, 0, 524, 525, 3,
-		10, 4, 0, 525, 526, 3, 28, 13, 0, 526, 527, 3, 44, 21, 0, 527, 528, 3,
-		10, 4, 0, 528, 529, 3, 24, 11, 0, 529, 530, 3, 30, 14, 0, 530, 531, 3,
-		32, 15, 0, 531, 532, 3, 10, 4, 0, 532, 109, 1, 0, 0, 0, 533, 536, 3, 174,
-		86, 0, 534, 536, 3, 176, 87, 0, 535, 533, 1, 0, 0, 0, 535, 534, 1, 0, 0,
-		0, 536, 111, 1, 0, 0, 0, 537, 538, 3, 136, 67, 0, 538, 539, 1, 0, 0, 0,
-		539, 540, 6, 55, 0, 0, 540, 541, 6, 55, 1, 0, 541, 113, 1, 0, 0, 0, 542,
-		546, 3, 116, 57, 0, 543, 545, 3, 118, 58, 0, 544, 543, 1, 0, 0, 0, 545,
-		548, 1, 0, 0, 0, 546, 544, 1, 0, 0, 0, 546, 547, 1, 0, 0, 0, 547, 554,
-		1, 0, 0, 0, 548, 546, 1, 0, 0, 0, 549, 550, 3, 130, 64, 0, 550, 551, 3,
-		114, 56, 0, 551, 552, 3, 130, 64, 0, 552, 554, 1, 0, 0, 0, 553, 542, 1,
-		0, 0, 0, 553, 549, 1, 0, 0, 0, 554, 115, 1, 0, 0, 0, 555, 556, 3, 120,
-		59, 0, 556, 117, 1, 0, 0, 0, 557, 562, 3, 120, 59, 0, 558, 562, 3, 122,
-		60, 0, 559, 562, 3, 128, 63, 0, 560, 562, 3, 126, 62, 0, 561, 557, 1, 0,
-		0, 0, 561, 558, 1, 0, 0, 0, 561, 559, 1, 0, 0, 0, 561, 560, 1, 0, 0, 0,
-		562, 119, 1, 0, 0, 0, 563, 564, 7, 26, 0, 0, 564, 121, 1, 0, 0, 0, 565,
-		566, 7, 27, 0, 0, 566, 123, 1, 0, 0, 0, 567, 568, 5, 35, 0, 0, 568, 125,
-		1, 0, 0, 0, 569, 570, 5, 36, 0, 0, 570, 127, 1, 0, 0, 0, 571, 572, 5, 95,
-		0, 0, 572, 129, 1, 0, 0, 0, 573, 574, 5, 34, 0, 0, 574, 131, 1, 0, 0, 0,
-		575, 576, 5, 37, 0, 0, 576, 133, 1, 0, 0, 0, 577, 578, 5, 38, 0, 0, 578,
-		135, 1, 0, 0, 0, 579, 580, 5, 39, 0, 0, 580, 137, 1, 0, 0, 0, 581, 582,
-		5, 40, 0, 0, 582, 139, 1, 0, 0, 0, 583, 584, 5, 41, 0, 0, 584, 141, 1,
-		0, 0, 0, 585, 586, 5, 91, 0, 0, 586, 143, 1, 0, 0, 0, 587, 588, 5, 93,
-		0, 0, 588, 145, 1, 0, 0, 0, 589, 590, 5, 42, 0, 0, 590, 147, 1, 0, 0, 0,
-		591, 592, 5, 43, 0, 0, 592, 149, 1, 0, 0, 0, 593, 594, 5, 44, 0, 0, 594,
-		151, 1, 0, 0, 0, 595, 596, 5, 45, 0, 0, 596, 153, 1, 0, 0, 0, 597, 598,
-		5, 46, 0, 0, 598, 155, 1, 0, 0, 0, 599, 600, 5, 47, 0, 0, 600, 157, 1,
-		0, 0, 0, 601, 602, 5, 94, 0, 0, 602, 159, 1, 0, 0, 0, 603, 604, 5, 124,
-		0, 0, 604, 605, 5, 124, 0, 0, 605, 161, 1, 0, 0, 0, 606, 607, 5, 58, 0,
-		0, 607, 163, 1, 0, 0, 0, 608, 609, 5, 59, 0, 0, 609, 165, 1, 0, 0, 0, 610,
-		611, 5, 63, 0, 0, 611, 167, 1, 0, 0, 0, 612, 613, 5, 124, 0, 0, 613, 169,
-		1, 0, 0, 0, 614, 615, 2, 48, 49, 0, 615, 171, 1, 0, 0, 0, 616, 624, 3,
-		122, 60, 0, 617, 624, 3, 2, 0, 0, 618, 624, 3, 4, 1, 0, 619, 624, 3, 6,
-		2, 0, 620, 624, 3, 8, 3, 0, 621, 624, 3, 10, 4, 0, 622, 624, 3, 12, 5,
-		0, 623, 616, 1, 0, 0, 0, 623, 617, 1, 0, 0, 0, 623, 618, 1, 0, 0, 0, 623,
-		619, 1, 0, 0, 0, 623, 620, 1, 0, 0, 0, 623, 621, 1, 0, 0, 0, 623, 622,
-		1, 0, 0, 0, 624, 173, 1, 0, 0, 0, 625, 628, 3, 178, 88, 0, 626, 628, 3,
-		180, 89, 0, 627, 625, 1, 0, 0, 0, 627, 626, 1, 0, 0, 0, 628, 175, 1, 0,
-		0, 0, 629, 631, 3, 190, 94, 0, 630, 629, 1, 0, 0, 0, 630, 631, 1, 0, 0,
-		0, 631, 632, 1, 0, 0, 0, 632, 635, 3, 178, 88, 0, 633, 635, 3, 180, 89,
-		0, 634, 630, 1, 0, 0, 0, 634, 633, 1, 0, 0, 0, 635, 177, 1, 0, 0, 0, 636,
-		641, 3, 188, 93, 0, 637, 639, 3, 154, 76, 0, 638, 640, 3, 188, 93, 0, 639,
-		638, 1, 0, 0, 0, 639, 640, 1, 0, 0, 0, 640, 642, 1, 0, 0, 0, 641, 637,
-		1, 0, 0, 0, 641, 642, 1, 0, 0, 0, 642, 647, 1, 0, 0, 0, 643, 644, 3, 154,
-		76, 0, 644, 645, 3, 188, 93, 0, 645, 647, 1, 0, 0, 0, 646, 636, 1, 0, 0,
-		0, 646, 643, 1, 0, 0, 0, 647, 179, 1, 0, 0, 0, 648, 649, 3, 182, 90, 0,
-		649, 650, 7, 4, 0, 0, 650, 651, 3, 184, 91, 0, 651, 181, 1, 0, 0, 0, 652,
-		653, 3, 178, 88, 0, 653, 183, 1, 0, 0, 0, 654, 655, 3, 186, 92, 0, 655,
-		185, 1, 0, 0, 0, 656, 658, 3, 190, 94, 0, 657, 656, 1, 0, 0, 0, 657, 658,
-		1, 0, 0, 0, 658, 659, 1, 0, 0, 0, 659, 660, 3, 188, 93, 0, 660, 187, 1,
-		0, 0, 0, 661, 663, 3, 122, 60, 0, 662, 661, 1, 0, 0, 0, 663, 664, 1, 0,
-		0, 0, 664, 662, 1, 0, 0, 0, 664, 665, 1, 0, 0, 0, 665, 189, 1, 0, 0, 0,
-		666, 669, 3, 148, 73, 0, 667, 669, 3, 152, 75, 0, 668, 666, 1, 0, 0, 0,
-		668, 667, 1, 0, 0, 0, 669, 191, 1, 0, 0, 0, 670, 671, 3, 194, 96, 0, 671,
-		193, 1, 0, 0, 0, 672, 682, 3, 196, 97, 0, 673, 674, 3, 196, 97, 0, 674,
-		675, 5, 84, 0, 0, 675, 676, 3, 204, 101, 0, 676, 682, 1, 0, 0, 0, 677,
-		678, 3, 214, 106, 0, 678, 679, 3, 138, 68, 0, 679, 680, 3, 140, 69, 0,
-		680, 682, 1, 0, 0, 0, 681, 672, 1, 0, 0, 0, 681, 673, 1, 0, 0, 0, 681,
-		677, 1, 0, 0, 0, 682, 195, 1, 0, 0, 0, 683, 684, 3, 198, 98, 0, 684, 685,
-		5, 45, 0, 0, 685, 686, 3, 200, 99, 0, 686, 687, 5, 45, 0, 0, 687, 688,
-		3, 202, 100, 0, 688, 197, 1, 0, 0, 0, 689, 690, 3, 122, 60, 0, 690, 691,
-		3, 122, 60, 0, 691, 692, 3, 122, 60, 0, 692, 693, 3, 122, 60, 0, 693, 199,
-		1, 0, 0, 0, 694, 695, 3, 122, 60, 0, 695, 696, 3, 122, 60, 0, 696, 201,
-		1, 0, 0, 0, 697, 698, 3, 122, 60, 0, 698, 699, 3, 122, 60, 0, 699, 203,
-		1, 0, 0, 0, 700, 701, 3, 208, 103, 0, 701, 702, 5, 58, 0, 0, 702, 705,
-		3, 210, 104, 0, 703, 704, 5, 58, 0, 0, 704, 706, 3, 212, 105, 0, 705, 703,
-		1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 708, 1, 0, 0, 0, 707, 709, 3, 206,
-		102, 0, 708, 707, 1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 205, 1, 0, 0,
-		0, 710, 717, 5, 90, 0, 0, 711, 712, 3, 190, 94, 0, 712, 713, 3, 208, 103,
-		0, 713, 714, 5, 58, 0, 0, 714, 715, 3, 210, 104, 0, 715, 717, 1, 0, 0,
-		0, 716, 710, 1, 0, 0, 0, 716, 711, 1, 0, 0, 0, 717, 207, 1, 0, 0, 0, 718,
-		719, 3, 122, 60, 0, 719, 720, 3, 122, 60, 0, 720, 209, 1, 0, 0, 0, 721,
-		722, 3, 122, 60, 0, 722, 723, 3, 122, 60, 0, 723, 211, 1, 0, 0, 0, 724,
-		725, 3, 122, 60, 0, 725, 732, 3, 122, 60, 0, 726, 728, 3, 154, 76, 0, 727,
-		729, 3, 122, 60, 0, 728, 727, 1, 0, 0, 0, 729, 730, 1, 0, 0, 0, 730, 728,
-		1, 0, 0, 0, 730, 731, 1, 0, 0, 0, 731, 733, 1, 0, 0, 0, 732, 726, 1, 0,
-		0, 0, 732, 733, 1, 0, 0, 0, 733, 213, 1, 0, 0, 0, 734, 735, 3, 28, 13,
-		0, 735, 736, 3, 30, 14, 0, 736, 737, 3, 46, 22, 0, 737, 215, 1, 0, 0, 0,
-		738, 740, 7, 28, 0, 0, 739, 738, 1, 0, 0, 0, 740, 741, 1, 0, 0, 0, 741,
-		739, 1, 0, 0, 0, 741, 742, 1, 0, 0, 0, 742, 743, 1, 0, 0, 0, 743, 744,
-		6, 107, 2, 0, 744, 217, 1, 0, 0, 0, 745, 746, 5, 39, 0, 0, 746, 747, 1,
-		0, 0, 0, 747, 748, 6, 108, 3, 0, 748, 219, 1, 0, 0, 0, 749, 750, 5, 39,
-		0, 0, 750, 751, 5, 39, 0, 0, 751, 752, 1, 0, 0, 0, 752, 753, 6, 109, 0,
-		0, 753, 221, 1, 0, 0, 0, 754, 755, 8, 29, 0, 0, 755, 756, 1, 0, 0, 0, 756,
-		757, 6, 110, 0, 0, 757, 223, 1, 0, 0, 0, 27, 0, 1, 282, 310, 360, 430,
-		535, 546, 553, 561, 623, 627, 630, 634, 639, 641, 646, 657, 664, 668, 681,
-		705, 708, 716, 730, 732, 741, 4, 3, 0, 0, 2, 1, 0, 6, 0, 0, 2, 0, 0,
+		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51,
+		1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
+		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53,
+		1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1,
+		53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54,
+		1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 3, 54, 578,
+		8, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1,
+		55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56,
+		1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1,
+		57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57,
+		1, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1,
+		58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1,
+		61, 1, 61, 3, 61, 654, 8, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63,
+		1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 4, 63, 667, 8, 63, 11, 63, 12, 63, 668,
+		1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 3, 64, 677, 8, 64, 1, 64, 1,
+		64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 5, 64, 688, 8, 64,
+		10, 64, 12, 64, 691, 9, 64, 1, 65, 1, 65, 1, 65, 1, 65, 5, 65, 697, 8,
+		65, 10, 65, 12, 65, 700, 9, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 67,
+		1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 5, 68, 713, 8, 68, 10, 68, 12, 68, 716,
+		9, 68, 1, 68, 1, 68, 1, 69, 1, 69, 5, 69, 722, 8, 69, 10, 69, 12, 69, 725,
+		9, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 731, 8, 69, 1, 70, 1, 70, 1,
+		71, 1, 71, 1, 71, 1, 71, 3, 71, 739, 8, 71, 1, 72, 1, 72, 1, 73, 1, 73,
+		1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 77, 1, 77, 1, 78, 1, 78, 1,
+		79, 1, 79, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84,
+		1, 84, 1, 85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 87, 1, 88, 1, 88, 1, 89, 1,
+		89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 1, 94,
+		1, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1,
+		98, 1, 98, 1, 98, 1, 98, 3, 98, 801, 8, 98, 1, 99, 1, 99, 3, 99, 805, 8,
+		99, 1, 100, 3, 100, 808, 8, 100, 1, 100, 1, 100, 3, 100, 812, 8, 100, 1,
+		101, 1, 101, 1, 101, 3, 101, 817, 8, 101, 3, 101, 819, 8, 101, 1, 101,
+		1, 101, 1, 101, 3, 101, 824, 8, 101, 1, 102, 1, 102, 1, 102, 1, 102, 1,
+		103, 1, 103, 1, 104, 1, 104, 1, 105, 3, 105, 835, 8, 105, 1, 105, 1, 105,
+		1, 106, 4, 106, 840, 8, 106, 11, 106, 12, 106, 841, 1, 107, 1, 107, 3,
+		107, 846, 8, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108,
+		1, 108, 1, 108, 1, 108, 1, 108, 5, 108, 859, 8, 108, 10, 108, 12, 108,
+		862, 9, 108, 1, 108, 1, 108, 5, 108, 866, 8, 108, 10, 108, 12, 108, 869,
+		9, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1, 108, 5, 108, 877, 8,
+		108, 10, 108, 12, 108, 880, 9, 108, 1, 108, 1, 108, 1, 108, 1, 108, 1,
+		108, 1, 108, 1, 108, 5, 108, 889, 8, 108, 10, 108, 12, 108, 892, 9, 108,
+		1, 108, 1, 108, 5, 108, 896, 8, 108, 10, 108, 12, 108, 899, 9, 108, 1,
+		108, 1, 108, 1, 108, 1, 108, 5, 108, 905, 8, 108, 10, 108, 12, 108, 908,
+		9, 108, 1, 108, 1, 108, 3, 108, 912, 8, 108, 1, 109, 1, 109, 1, 109, 1,
+		109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 3, 109, 923, 8, 109, 1, 110,
+		1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 1, 111,
+		1, 111, 1, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114,
+		1, 114, 1, 114, 1, 114, 3, 114, 947, 8, 114, 1, 114, 3, 114, 950, 8, 114,
+		1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 1, 115, 3, 115, 958, 8, 115, 1,
+		116, 1, 116, 1, 116, 1, 117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1,
+		118, 4, 118, 970, 8, 118, 11, 118, 12, 118, 971, 3, 118, 974, 8, 118, 1,
+		119, 1, 119, 1, 119, 1, 119, 1, 120, 4, 120, 981, 8, 120, 11, 120, 12,
+		120, 982, 1, 120, 1, 120, 1, 121, 1, 121, 1, 121, 1, 121, 1, 122, 1, 122,
+		1, 122, 1, 122, 1, 122, 1, 123, 1, 123, 1, 123, 1, 123, 0, 0, 124, 2, 0,
+		4, 0, 6, 0, 8, 0, 10, 0, 12, 0, 14, 0, 16, 0, 18, 0, 20, 0, 22, 0, 24,
+		0, 26, 0, 28, 0, 30, 0, 32, 0, 34, 0, 36, 0, 38, 0, 40, 0, 42, 0, 44, 0,
+		46, 0, 48, 0, 50, 0, 52, 0, 54, 1, 56, 2, 58, 3, 60, 4, 62, 5, 64, 6, 66,
+		7, 68, 8, 70, 9, 72, 10, 74, 11, 76, 12, 78, 13, 80, 14, 82, 15, 84, 16,
+		86, 17, 88, 18, 90, 19, 92, 20, 94, 21, 96, 22, 98, 23, 100, 24, 102, 25,
+		104, 26, 106, 27, 108, 28, 110, 29, 112, 30, 114, 31, 116, 32, 118, 33,
+		120, 34, 122, 35, 124, 36, 126, 0, 128, 37, 130, 38, 132, 39, 134, 0, 136,
+		0, 138, 0, 140, 40, 142, 41, 144, 42, 146, 43, 148, 44, 150, 45, 152, 46,
+		154, 47, 156, 48, 158, 49, 160, 50, 162, 51, 164, 52, 166, 53, 168, 54,
+		170, 55, 172, 56, 174, 57, 176, 58, 178, 59, 180, 60, 182, 61, 184, 62,
+		186, 63, 188, 64, 190, 65, 192, 66, 194, 67, 196, 68, 198, 69, 200, 70,
+		202, 71, 204, 72, 206, 73, 208, 74, 210, 75, 212, 76, 214, 77, 216, 78,
+		218, 79, 220, 80, 222, 81, 224, 82, 226, 83, 228, 84, 230, 85, 232, 86,
+		234, 87, 236, 88, 238, 89, 240, 90, 242, 91, 244, 92, 246, 93, 248, 0,
+		2, 0, 1, 33, 2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67,
+		99, 99, 2, 0, 68, 68, 100, 100, 2, 0, 69, 69, 101, 101, 2, 0, 70, 70, 102,
+		102, 2, 0, 71, 71, 103, 103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105,
+		105, 2, 0, 74, 74, 106, 106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108,
+		108, 2, 0, 77, 77, 109, 109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111,
+		111, 2, 0, 80, 80, 112, 112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114,
+		114, 2, 0, 83, 83, 115, 115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117,
+		117, 2, 0, 86, 86, 118, 118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120,
+		120, 2, 0, 89, 89, 121, 121, 2, 0, 90, 90, 122, 122, 3, 0, 34, 34, 123,
+		123, 125, 125, 3, 0, 48, 57, 65, 70, 97, 102, 2, 0, 34, 34, 92, 92, 2,
+		0, 65, 90, 97, 122, 1, 0, 48, 57, 3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 39,
+		39, 1034, 0, 54, 1, 0, 0, 0, 0, 56, 1, 0, 0, 0, 0, 58, 1, 0, 0, 0, 0, 60,
+		1, 0, 0, 0, 0, 62, 1, 0, 0, 0, 0, 64, 1, 0, 0, 0, 0, 66, 1, 0, 0, 0, 0,
+		68, 1, 0, 0, 0, 0, 70, 1, 0, 0, 0, 0, 72, 1, 0, 0, 0, 0, 74, 1, 0, 0, 0,
+		0, 76, 1, 0, 0, 0, 0, 78, 1, 0, 0, 0, 0, 80, 1, 0, 0, 0, 0, 82, 1, 0, 0,
+		0, 0, 84, 1, 0, 0, 0, 0, 86, 1, 0, 0, 0, 0, 88, 1, 0, 0, 0, 0, 90, 1, 0,
+		0, 0, 0, 92, 1, 0, 0, 0, 0, 94, 1, 0, 0, 0, 0, 96, 1, 0, 0, 0, 0, 98, 1,
+		0, 0, 0, 0, 100, 1, 0, 0, 0, 0, 102, 1, 0, 0, 0, 0, 104, 1, 0, 0, 0, 0,
+		106, 1, 0, 0, 0, 0, 108, 1, 0, 0, 0, 0, 110, 1, 0, 0, 0, 0, 112, 1, 0,
+		0, 0, 0, 114, 1, 0, 0, 0, 0, 116, 1, 0, 0, 0, 0, 118, 1, 0, 0, 0, 0, 120,
+		1, 0, 0, 0, 0, 122, 1, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 126, 1, 0, 0, 0,
+		0, 128, 1, 0, 0, 0, 0, 130, 1, 0, 0, 0, 0, 132, 1, 0, 0, 0, 0, 140, 1,
+		0, 0, 0, 0, 142, 1, 0, 0, 0, 0, 144, 1, 0, 0, 0, 0, 146, 1, 0, 0, 0, 0,
+		148, 1, 0, 0, 0, 0, 150, 1, 0, 0, 0, 0, 152, 1, 0, 0, 0, 0, 154, 1, 0,
+		0, 0, 0, 156, 1, 0, 0, 0, 0, 158, 1, 0, 0, 0, 0, 160, 1, 0, 0, 0, 0, 162,
+		1, 0, 0, 0, 0, 164, 1, 0, 0, 0, 0, 166, 1, 0, 0, 0, 0, 168, 1, 0, 0, 0,
+		0, 170, 1, 0, 0, 0, 0, 172, 1, 0, 0, 0, 0, 174, 1, 0, 0, 0, 0, 176, 1,
+		0, 0, 0, 0, 178, 1, 0, 0, 0, 0, 180, 1, 0, 0, 0, 0, 182, 1, 0, 0, 0, 0,
+		184, 1, 0, 0, 0, 0, 186, 1, 0, 0, 0, 0, 188, 1, 0, 0, 0, 0, 190, 1, 0,
+		0, 0, 0, 192, 1, 0, 0, 0, 0, 194, 1, 0, 0, 0, 0, 196, 1, 0, 0, 0, 0, 198,
+		1, 0, 0, 0, 0, 200, 1, 0, 0, 0, 0, 202, 1, 0, 0, 0, 0, 204, 1, 0, 0, 0,
+		0, 206, 1, 0, 0, 0, 0, 208, 1, 0, 0, 0, 0, 210, 1, 0, 0, 0, 0, 212, 1,
+		0, 0, 0, 0, 214, 1, 0, 0, 0, 0, 216, 1, 0, 0, 0, 0, 218, 1, 0, 0, 0, 0,
+		220, 1, 0, 0, 0, 0, 222, 1, 0, 0, 0, 0, 224, 1, 0, 0, 0, 0, 226, 1, 0,
+		0, 0, 0, 228, 1, 0, 0, 0, 0, 230, 1, 0, 0, 0, 0, 232, 1, 0, 0, 0, 0, 234,
+		1, 0, 0, 0, 0, 236, 1, 0, 0, 0, 0, 238, 1, 0, 0, 0, 0, 240, 1, 0, 0, 0,
+		0, 242, 1, 0, 0, 0, 1, 244, 1, 0, 0, 0, 1, 246, 1, 0, 0, 0, 1, 248, 1,
+		0, 0, 0, 2, 250, 1, 0, 0, 0, 4, 252, 1, 0, 0, 0, 6, 254, 1, 0, 0, 0, 8,
+		256, 1, 0, 0, 0, 10, 258, 1, 0, 0, 0, 12, 260, 1, 0, 0, 0, 14, 262, 1,
+		0, 0, 0, 16, 264, 1, 0, 0, 0, 18, 266, 1, 0, 0, 0, 20, 268, 1, 0, 0, 0,
+		22, 270, 1, 0, 0, 0, 24, 272, 1, 0, 0, 0, 26, 274, 1, 0, 0, 0, 28, 276,
+		1, 0, 0, 0, 30, 278, 1, 0, 0, 0, 32, 280, 1, 0, 0, 0, 34, 282, 1, 0, 0,
+		0, 36, 284, 1, 0, 0, 0, 38, 286, 1, 0, 0, 0, 40, 288, 1, 0, 0, 0, 42, 290,
+		1, 0, 0, 0, 44, 292, 1, 0, 0, 0, 46, 294, 1, 0, 0, 0, 48, 296, 1, 0, 0,
+		0, 50, 298, 1, 0, 0, 0, 52, 300, 1, 0, 0, 0, 54, 308, 1, 0, 0, 0, 56, 310,
+		1, 0, 0, 0, 58, 312, 1, 0, 0, 0, 60, 314, 1, 0, 0, 0, 62, 316, 1, 0, 0,
+		0, 64, 319, 1, 0, 0, 0, 66, 322, 1, 0, 0, 0, 68, 336, 1, 0, 0, 0, 70, 338,
+		1, 0, 0, 0, 72, 342, 1, 0, 0, 0, 74, 345, 1, 0, 0, 0, 76, 349, 1, 0, 0,
+		0, 78, 354, 1, 0, 0, 0, 80, 360, 1, 0, 0, 0, 82, 368, 1, 0, 0, 0, 84, 371,
+		1, 0, 0, 0, 86, 376, 1, 0, 0, 0, 88, 379, 1, 0, 0, 0, 90, 394, 1, 0, 0,
+		0, 92, 399, 1, 0, 0, 0, 94, 471, 1, 0, 0, 0, 96, 479, 1, 0, 0, 0, 98, 485,
+		1, 0, 0, 0, 100, 496, 1, 0, 0, 0, 102, 504, 1, 0, 0, 0, 104, 515, 1, 0,
+		0, 0, 106, 531, 1, 0, 0, 0, 108, 544, 1, 0, 0, 0, 110, 577, 1, 0, 0, 0,
+		112, 579, 1, 0, 0, 0, 114, 594, 1, 0, 0, 0, 116, 608, 1, 0, 0, 0, 118,
+		621, 1, 0, 0, 0, 120, 632, 1, 0, 0, 0, 122, 645, 1, 0, 0, 0, 124, 653,
+		1, 0, 0, 0, 126, 655, 1, 0, 0, 0, 128, 660, 1, 0, 0, 0, 130, 676, 1, 0,
+		0, 0, 132, 692, 1, 0, 0, 0, 134, 703, 1, 0, 0, 0, 136, 706, 1, 0, 0, 0,
+		138, 708, 1, 0, 0, 0, 140, 730, 1, 0, 0, 0, 142, 732, 1, 0, 0, 0, 144,
+		738, 1, 0, 0, 0, 146, 740, 1, 0, 0, 0, 148, 742, 1, 0, 0, 0, 150, 744,
+		1, 0, 0, 0, 152, 746, 1, 0, 0, 0, 154, 748, 1, 0, 0, 0, 156, 750, 1, 0,
+		0, 0, 158, 752, 1, 0, 0, 0, 160, 754, 1, 0, 0, 0, 162, 756, 1, 0, 0, 0,
+		164, 758, 1, 0, 0, 0, 166, 760, 1, 0, 0, 0, 168, 762, 1, 0, 0, 0, 170,
+		764, 1, 0, 0, 0, 172, 766, 1, 0, 0, 0, 174, 768, 1, 0, 0, 0, 176, 770,
+		1, 0, 0, 0, 178, 772, 1, 0, 0, 0, 180, 774, 1, 0, 0, 0, 182, 776, 1, 0,
+		0, 0, 184, 778, 1, 0, 0, 0, 186, 780, 1, 0, 0, 0, 188, 783, 1, 0, 0, 0,
+		190, 785, 1, 0, 0, 0, 192, 787, 1, 0, 0, 0, 194, 789, 1, 0, 0, 0, 196,
+		791, 1, 0, 0, 0, 198, 800, 1, 0, 0, 0, 200, 804, 1, 0, 0, 0, 202, 811,
+		1, 0, 0, 0, 204, 823, 1, 0, 0, 0, 206, 825, 1, 0, 0, 0, 208, 829, 1, 0,
+		0, 0, 210, 831, 1, 0, 0, 0, 212, 834, 1, 0, 0, 0, 214, 839, 1, 0, 0, 0,
+		216, 845, 1, 0, 0, 0, 218, 911, 1, 0, 0, 0, 220, 922, 1, 0, 0, 0, 222,
+		924, 1, 0, 0, 0, 224, 930, 1, 0, 0, 0, 226, 935, 1, 0, 0, 0, 228, 938,
+		1, 0, 0, 0, 230, 941, 1, 0, 0, 0, 232, 957, 1, 0, 0, 0, 234, 959, 1, 0,
+		0, 0, 236, 962, 1, 0, 0, 0, 238, 965, 1, 0, 0, 0, 240, 975, 1, 0, 0, 0,
+		242, 980, 1, 0, 0, 0, 244, 986, 1, 0, 0, 0, 246, 990, 1, 0, 0, 0, 248,
+		995, 1, 0, 0, 0, 250, 251, 7, 0, 0, 0, 251, 3, 1, 0, 0, 0, 252, 253, 7,
+		1, 0, 0, 253, 5, 1, 0, 0, 0, 254, 255, 7, 2, 0, 0, 255, 7, 1, 0, 0, 0,
+		256, 257, 7, 3, 0, 0, 257, 9, 1, 0, 0, 0, 258, 259, 7, 4, 0, 0, 259, 11,
+		1, 0, 0, 0, 260, 261, 7, 5, 0, 0, 261, 13, 1, 0, 0, 0, 262, 263, 7, 6,
+		0, 0, 263, 15, 1, 0, 0, 0, 264, 265, 7, 7, 0, 0, 265, 17, 1, 0, 0, 0, 266,
+		267, 7, 8, 0, 0, 267, 19, 1, 0, 0, 0, 268, 269, 7, 9, 0, 0, 269, 21, 1,
+		0, 0, 0, 270, 271, 7, 10, 0, 0, 271, 23, 1, 0, 0, 0, 272, 273, 7, 11, 0,
+		0, 273, 25, 1, 0, 0, 0, 274, 275, 7, 12, 0, 0, 275, 27, 1, 0, 0, 0, 276,
+		277, 7, 13, 0, 0, 277, 29, 1, 0, 0, 0, 278, 279, 7, 14, 0, 0, 279, 31,
+		1, 0, 0, 0, 280, 281, 7, 15, 0, 0, 281, 33, 1, 0, 0, 0, 282, 283, 7, 16,
+		0, 0, 283, 35, 1, 0, 0, 0, 284, 285, 7, 17, 0, 0, 285, 37, 1, 0, 0, 0,
+		286, 287, 7, 18, 0, 0, 287, 39, 1, 0, 0, 0, 288, 289, 7, 19, 0, 0, 289,
+		41, 1, 0, 0, 0, 290, 291, 7, 20, 0, 0, 291, 43, 1, 0, 0, 0, 292, 293, 7,
+		21, 0, 0, 293, 45, 1, 0, 0, 0, 294, 295, 7, 22, 0, 0, 295, 47, 1, 0, 0,
+		0, 296, 297, 7, 23, 0, 0, 297, 49, 1, 0, 0, 0, 298, 299, 7, 24, 0, 0, 299,
+		51, 1, 0, 0, 0, 300, 301, 7, 25, 0, 0, 301, 53, 1, 0, 0, 0, 302, 309, 3,
+		58, 28, 0, 303, 309, 3, 62, 30, 0, 304, 309, 3, 56, 27, 0, 305, 309, 3,
+		60, 29, 0, 306, 309, 3, 66, 32, 0, 307, 309, 3, 64, 31, 0, 308, 302, 1,
+		0, 0, 0, 308, 303, 1, 0, 0, 0, 308, 304, 1, 0, 0, 0, 308, 305, 1, 0, 0,
+		0, 308, 306, 1, 0, 0, 0, 308, 307, 1, 0, 0, 0, 309, 55, 1, 0, 0, 0, 310,
+		311, 5, 60, 0, 0, 311, 57, 1, 0, 0, 0, 312, 313, 5, 61, 0, 0, 313, 59,
+		1, 0, 0, 0, 314, 315, 5, 62, 0, 0, 315, 61, 1, 0, 0, 0, 316, 317, 3, 56,
+		27, 0, 317, 318, 3, 60, 29, 0, 318, 63, 1, 0, 0, 0, 319, 320, 3, 60, 29,
+		0, 320, 321, 3, 58, 28, 0, 321, 65, 1, 0, 0, 0, 322, 323, 3, 56, 27, 0,
+		323, 324, 3, 58, 28, 0, 324, 67, 1, 0, 0, 0, 325, 326, 3, 40, 19, 0, 326,
+		327, 3, 36, 17, 0, 327, 328, 3, 42, 20, 0, 328, 329, 3, 10, 4, 0, 329,
+		337, 1, 0, 0, 0, 330, 331, 3, 12, 5, 0, 331, 332, 3, 2, 0, 0, 332, 333,
+		3, 24, 11, 0, 333, 334, 3, 38, 18, 0, 334, 335, 3, 10, 4, 0, 335, 337,
+		1, 0, 0, 0, 336, 325, 1, 0, 0, 0, 336, 330, 1, 0, 0, 0, 337, 69, 1, 0,
+		0, 0, 338, 339, 3, 2, 0, 0, 339, 340, 3, 28, 13, 0, 340, 341, 3, 8, 3,
+		0, 341, 71, 1, 0, 0, 0, 342, 343, 3, 30, 14, 0, 343, 344, 3, 36, 17, 0,
+		344, 73, 1, 0, 0, 0, 345, 346, 3, 28, 13, 0, 346, 347, 3, 30, 14, 0, 347,
+		348, 3, 40, 19, 0, 348, 75, 1, 0, 0, 0, 349, 350, 3, 24, 11, 0, 350, 351,
+		3, 18, 8, 0, 351, 352, 3, 22, 10, 0, 352, 353, 3, 10, 4, 0, 353, 77, 1,
+		0, 0, 0, 354, 355, 3, 18, 8, 0, 355, 356, 3, 24, 11, 0, 356, 357, 3, 18,
+		8, 0, 357, 358, 3, 22, 10, 0, 358, 359, 3, 10, 4, 0, 359, 79, 1, 0, 0,
+		0, 360, 361, 3, 4, 1, 0, 361, 362, 3, 10, 4, 0, 362, 363, 3, 40, 19, 0,
+		363, 364, 3, 46, 22, 0, 364, 365, 3, 10, 4, 0, 365, 366, 3, 10, 4, 0, 366,
+		367, 3, 28, 13, 0, 367, 81, 1, 0, 0, 0, 368, 369, 3, 18, 8, 0, 369, 370,
+		3, 38, 18, 0, 370, 83, 1, 0, 0, 0, 371, 372, 3, 28, 13, 0, 372, 373, 3,
+		42, 20, 0, 373, 374, 3, 24, 11, 0, 374, 375, 3, 24, 11, 0, 375, 85, 1,
+		0, 0, 0, 376, 377, 3, 18, 8, 0, 377, 378, 3, 28, 13, 0, 378, 87, 1, 0,
+		0, 0, 379, 380, 3, 42, 20, 0, 380, 381, 3, 28, 13, 0, 381, 382, 3, 22,
+		10, 0, 382, 383, 3, 28, 13, 0, 383, 384, 3, 30, 14, 0, 384, 385, 3, 46,
+		22, 0, 385, 386, 3, 28, 13, 0, 386, 89, 1, 0, 0, 0, 387, 395, 3, 174, 86,
+		0, 388, 395, 3, 178, 88, 0, 389, 395, 3, 172, 85, 0, 390, 395, 3, 182,
+		90, 0, 391, 395, 3, 158, 78, 0, 392, 395, 3, 184, 91, 0, 393, 395, 3, 186,
+		92, 0, 394, 387, 1, 0, 0, 0, 394, 388, 1, 0, 0, 0, 394, 389, 1, 0, 0, 0,
+		394, 390, 1, 0, 0, 0, 394, 391, 1, 0, 0, 0, 394, 392, 1, 0, 0, 0, 394,
+		393, 1, 0, 0, 0, 395, 91, 1, 0, 0, 0, 396, 397, 3, 38, 18, 0, 397, 398,
+		5, 95, 0, 0, 398, 400, 1, 0, 0, 0, 399, 396, 1, 0, 0, 0, 399, 400, 1, 0,
+		0, 0, 400, 469, 1, 0, 0, 0, 401, 402, 3, 10, 4, 0, 402, 403, 3, 34, 16,
+		0, 403, 404, 3, 42, 20, 0, 404, 405, 3, 2, 0, 0, 405, 406, 3, 24, 11, 0,
+		406, 407, 3, 38, 18, 0, 407, 470, 1, 0, 0, 0, 408, 409, 3, 8, 3, 0, 409,
+		410, 3, 18, 8, 0, 410, 411, 3, 38, 18, 0, 411, 412, 3, 20, 9, 0, 412, 413,
+		3, 30, 14, 0, 413, 414, 3, 18, 8, 0, 414, 415, 3, 28, 13, 0, 415, 416,
+		3, 40, 19, 0, 416, 470, 1, 0, 0, 0, 417, 418, 3, 40, 19, 0, 418, 419, 3,
+		30, 14, 0, 419, 420, 3, 42, 20, 0, 420, 421, 3, 6, 2, 0, 421, 422, 3, 16,
+		7, 0, 422, 423, 3, 10, 4, 0, 423, 424, 3, 38, 18, 0, 424, 470, 1, 0, 0,
+		0, 425, 426, 3, 46, 22, 0, 426, 427, 3, 18, 8, 0, 427, 428, 3, 40, 19,
+		0, 428, 429, 3, 16, 7, 0, 429, 430, 3, 18, 8, 0, 430, 431, 3, 28, 13, 0,
+		431, 470, 1, 0, 0, 0, 432, 433, 3, 30, 14, 0, 433, 434, 3, 44, 21, 0, 434,
+		435, 3, 10, 4, 0, 435, 436, 3, 36, 17, 0, 436, 437, 3, 24, 11, 0, 437,
+		438, 3, 2, 0, 0, 438, 439, 3, 32, 15, 0, 439, 440, 3, 38, 18, 0, 440, 470,
+		1, 0, 0, 0, 441, 442, 3, 6, 2, 0, 442, 443, 3, 36, 17, 0, 443, 444, 3,
+		30, 14, 0, 444, 445, 3, 38, 18, 0, 445, 446, 3, 38, 18, 0, 446, 447, 3,
+		10, 4, 0, 447, 448, 3, 38, 18, 0, 448, 470, 1, 0, 0, 0, 449, 450, 3, 18,
+		8, 0, 450, 451, 3, 28, 13, 0, 451, 452, 3, 40, 19, 0, 452, 453, 3, 10,
+		4, 0, 453, 454, 3, 36, 17, 0, 454, 455, 3, 38, 18, 0, 455, 456, 3, 10,
+		4, 0, 456, 457, 3, 6, 2, 0, 457, 458, 3, 40, 19, 0, 458, 459, 3, 38, 18,
+		0, 459, 470, 1, 0, 0, 0, 460, 461, 3, 6, 2, 0, 461, 462, 3, 30, 14, 0,
+		462, 463, 3, 28, 13, 0, 463, 464, 3, 40, 19, 0, 464, 465, 3, 2, 0, 0, 465,
+		466, 3, 18, 8, 0, 466, 467, 3, 28, 13, 0, 467, 468, 3, 38, 18, 0, 468,
+		470, 1, 0, 0, 0, 469, 401, 1, 0, 0, 0, 469, 408, 1, 0, 0, 0, 469, 417,
+		1, 0, 0, 0, 469, 425, 1, 0, 0, 0, 469, 432, 1, 0, 0, 0, 469, 441, 1, 0,
+		0, 0, 469, 449, 1, 0, 0, 0, 469, 460, 1, 0, 0, 0, 470, 93, 1, 0, 0, 0,
+		471, 472, 3, 8, 3, 0, 472, 473, 3, 46, 22, 0, 473, 474, 3, 18, 8, 0, 474,
+		475, 3, 40, 19, 0, 475, 476, 3, 16, 7, 0, 476, 477, 3, 18, 8, 0, 477, 478,
+		3, 28, 13, 0, 478, 95, 1, 0, 0, 0, 479, 480, 3, 32, 15, 0, 480, 481, 3,
+		30, 14, 0, 481, 482, 3, 18, 8, 0, 482, 483, 3, 28, 13, 0, 483, 484, 3,
+		40, 19, 0, 484, 97, 1, 0, 0, 0, 485, 486, 3, 24, 11, 0, 486, 487, 3, 18,
+		8, 0, 487, 488, 3, 28, 13, 0, 488, 489, 3, 10, 4, 0, 489, 490, 3, 38, 18,
+		0, 490, 491, 3, 40, 19, 0, 491, 492, 3, 36, 17, 0, 492, 493, 3, 18, 8,
+		0, 493, 494, 3, 28, 13, 0, 494, 495, 3, 14, 6, 0, 495, 99, 1, 0, 0, 0,
+		496, 497, 3, 32, 15, 0, 497, 498, 3, 30, 14, 0, 498, 499, 3, 24, 11, 0,
+		499, 500, 3, 50, 24, 0, 500, 501, 3, 14, 6, 0, 501, 502, 3, 30, 14, 0,
+		502, 503, 3, 28, 13, 0, 503, 101, 1, 0, 0, 0, 504, 505, 3, 26, 12, 0, 505,
+		506, 3, 42, 20, 0, 506, 507, 3, 24, 11, 0, 507, 508, 3, 40, 19, 0, 508,
+		509, 3, 18, 8, 0, 509, 510, 3, 32, 15, 0, 510, 511, 3, 30, 14, 0, 511,
+		512, 3, 18, 8, 0, 512, 513, 3, 28, 13, 0, 513, 514, 3, 40, 19, 0, 514,
+		103, 1, 0, 0, 0, 515, 516, 3, 26, 12, 0, 516, 517, 3, 42, 20, 0, 517, 518,
+		3, 24, 11, 0, 518, 519, 3, 40, 19, 0, 519, 520, 3, 18, 8, 0, 520, 521,
+		3, 24, 11, 0, 521, 522, 3, 18, 8, 0, 522, 523, 3, 28, 13, 0, 523, 524,
+		3, 10, 4, 0, 524, 525, 3, 38, 18, 0, 525, 526, 3, 40, 19, 0, 526, 527,
+		3, 36, 17, 0, 527, 528, 3, 18, 8, 0, 528, 529, 3, 28, 13, 0, 529, 530,
+		3, 14, 6, 0, 530, 105, 1, 0, 0, 0, 531, 532, 3, 26, 12, 0, 532, 533, 3,
+		42, 20, 0, 533, 534, 3, 24, 11, 0, 534, 535, 3, 40, 19, 0, 535, 536, 3,
+		18, 8, 0, 536, 537, 3, 32, 15, 0, 537, 538, 3, 30, 14, 0, 538, 539, 3,
+		24, 11, 0, 539, 540, 3, 50, 24, 0, 540, 541, 3, 14, 6, 0, 541, 542, 3,
+		30, 14, 0, 542, 543, 3, 28, 13, 0, 543, 107, 1, 0, 0, 0, 544, 545, 3, 14,
+		6, 0, 545, 546, 3, 10, 4, 0, 546, 547, 3, 30, 14, 0, 547, 548, 3, 26, 12,
+		0, 548, 549, 3, 10, 4, 0, 549, 550, 3, 40, 19, 0, 550, 551, 3, 36, 17,
+		0, 551, 552, 3, 50, 24, 0, 552, 553, 3, 6, 2, 0, 553, 554, 3, 30, 14, 0,
+		554, 555, 3, 24, 11, 0, 555, 556, 3, 24, 11, 0, 556, 557, 3, 10, 4, 0,
+		557, 558, 3, 6, 2, 0, 558, 559, 3, 40, 19, 0, 559, 560, 3, 18, 8, 0, 560,
+		561, 3, 30, 14, 0, 561, 562, 3, 28, 13, 0, 562, 109, 1, 0, 0, 0, 563, 564,
+		3, 10, 4, 0, 564, 565, 3, 28, 13, 0, 565, 566, 3, 44, 21, 0, 566, 567,
+		3, 10, 4, 0, 567, 568, 3, 24, 11, 0, 568, 569, 3, 30, 14, 0, 569, 570,
+		3, 32, 15, 0, 570, 571, 3, 10, 4, 0, 571, 578, 1, 0, 0, 0, 572, 573, 3,
+		4, 1, 0, 573, 574, 3, 4, 1, 0, 574, 575, 3, 30, 14, 0, 575, 576, 3, 48,
+		23, 0, 576, 578, 1, 0, 0, 0, 577, 563, 1, 0, 0, 0, 577, 572, 1, 0, 0, 0,
+		578, 111, 1, 0, 0, 0, 579, 580, 3, 6, 2, 0, 580, 581, 3, 18, 8, 0, 581,
+		582, 3, 36, 17, 0, 582, 583, 3, 6, 2, 0, 583, 584, 3, 42, 20, 0, 584, 585,
+		3, 24, 11, 0, 585, 586, 3, 2, 0, 0, 586, 587, 3, 36, 17, 0, 587, 588, 3,
+		38, 18, 0, 588, 589, 3, 40, 19, 0, 589, 590, 3, 36, 17, 0, 590, 591, 3,
+		18, 8, 0, 591, 592, 3, 28, 13, 0, 592, 593, 3, 14, 6, 0, 593, 113, 1, 0,
+		0, 0, 594, 595, 3, 6, 2, 0, 595, 596, 3, 30, 14, 0, 596, 597, 3, 26, 12,
+		0, 597, 598, 3, 32, 15, 0, 598, 599, 3, 30, 14, 0, 599, 600, 3, 42, 20,
+		0, 600, 601, 3, 28, 13, 0, 601, 602, 3, 8, 3, 0, 602, 603, 3, 6, 2, 0,
+		603, 604, 3, 42, 20, 0, 604, 605, 3, 36, 17, 0, 605, 606, 3, 44, 21, 0,
+		606, 607, 3, 10, 4, 0, 607, 115, 1, 0, 0, 0, 608, 609, 3, 6, 2, 0, 609,
+		610, 3, 42, 20, 0, 610, 611, 3, 36, 17, 0, 611, 612, 3, 44, 21, 0, 612,
+		613, 3, 10, 4, 0, 613, 614, 3, 32, 15, 0, 614, 615, 3, 30, 14, 0, 615,
+		616, 3, 24, 11, 0, 616, 617, 3, 50, 24, 0, 617, 618, 3, 14, 6, 0, 618,
+		619, 3, 30, 14, 0, 619, 620, 3, 28, 13, 0, 620, 117, 1, 0, 0, 0, 621, 622,
+		3, 26, 12, 0, 622, 623, 3, 42, 20, 0, 623, 624, 3, 24, 11, 0, 624, 625,
+		3, 40, 19, 0, 625, 626, 3, 18, 8, 0, 626, 627, 3, 6, 2, 0, 627, 628, 3,
+		42, 20, 0, 628, 629, 3, 36, 17, 0, 629, 630, 3, 44, 21, 0, 630, 631, 3,
+		10, 4, 0, 631, 119, 1, 0, 0, 0, 632, 633, 3, 26, 12, 0, 633, 634, 3, 42,
+		20, 0, 634, 635, 3, 24, 11, 0, 635, 636, 3, 40, 19, 0, 636, 637, 3, 18,
+		8, 0, 637, 638, 3, 38, 18, 0, 638, 639, 3, 42, 20, 0, 639, 640, 3, 36,
+		17, 0, 640, 641, 3, 12, 5, 0, 641, 642, 3, 2, 0, 0, 642, 643, 3, 6, 2,
+		0, 643, 644, 3, 10, 4, 0, 644, 121, 1, 0, 0, 0, 645, 646, 3, 10, 4, 0,
+		646, 647, 3, 26, 12, 0, 647, 648, 3, 32, 15, 0, 648, 649, 3, 40, 19, 0,
+		649, 650, 3, 50, 24, 0, 650, 123, 1, 0, 0, 0, 651, 654, 3, 200, 99, 0,
+		652, 654, 3, 202, 100, 0, 653, 651, 1, 0, 0, 0, 653, 652, 1, 0, 0, 0, 654,
+		125, 1, 0, 0, 0, 655, 656, 3, 162, 80, 0, 656, 657, 1, 0, 0, 0, 657, 658,
+		6, 62, 0, 0, 658, 659, 6, 62, 1, 0, 659, 127, 1, 0, 0, 0, 660, 661, 3,
+		38, 18, 0, 661, 662, 3, 36, 17, 0, 662, 663, 3, 18, 8, 0, 663, 664, 3,
+		8, 3, 0, 664, 666, 3, 58, 28, 0, 665, 667, 3, 148, 73, 0, 666, 665, 1,
+		0, 0, 0, 667, 668, 1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 668, 669, 1, 0, 0,
+		0, 669, 670, 1, 0, 0, 0, 670, 671, 3, 190, 94, 0, 671, 129, 1, 0, 0, 0,
+		672, 673, 5, 48, 0, 0, 673, 677, 5, 48, 0, 0, 674, 675, 5, 48, 0, 0, 675,
+		677, 5, 49, 0, 0, 676, 672, 1, 0, 0, 0, 676, 674, 1, 0, 0, 0, 677, 678,
+		1, 0, 0, 0, 678, 679, 3, 134, 66, 0, 679, 680, 3, 134, 66, 0, 680, 681,
+		3, 134, 66, 0, 681, 682, 3, 134, 66, 0, 682, 683, 3, 134, 66, 0, 683, 684,
+		3, 134, 66, 0, 684, 685, 3, 134, 66, 0, 685, 689, 3, 134, 66, 0, 686, 688,
+		3, 134, 66, 0, 687, 686, 1, 0, 0, 0, 688, 691, 1, 0, 0, 0, 689, 687, 1,
+		0, 0, 0, 689, 690, 1, 0, 0, 0, 690, 131, 1, 0, 0, 0, 691, 689, 1, 0, 0,
+		0, 692, 698, 5, 123, 0, 0, 693, 697, 3, 138, 68, 0, 694, 697, 3, 132, 65,
+		0, 695, 697, 8, 26, 0, 0, 696, 693, 1, 0, 0, 0, 696, 694, 1, 0, 0, 0, 696,
+		695, 1, 0, 0, 0, 697, 700, 1, 0, 0, 0, 698, 696, 1, 0, 0, 0, 698, 699,
+		1, 0, 0, 0, 699, 701, 1, 0, 0, 0, 700, 698, 1, 0, 0, 0, 701, 702, 5, 125,
+		0, 0, 702, 133, 1, 0, 0, 0, 703, 704, 3, 136, 67, 0, 704, 705, 3, 136,
+		67, 0, 705, 135, 1, 0, 0, 0, 706, 707, 7, 27, 0, 0, 707, 137, 1, 0, 0,
+		0, 708, 714, 5, 34, 0, 0, 709, 713, 8, 28, 0, 0, 710, 711, 5, 92, 0, 0,
+		711, 713, 9, 0, 0, 0, 712, 709, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0, 713,
+		716, 1, 0, 0, 0, 714, 712, 1, 0, 0, 0, 714, 715, 1, 0, 0, 0, 715, 717,
+		1, 0, 0, 0, 716, 714, 1, 0, 0, 0, 717, 718, 5, 34, 0, 0, 718, 139, 1, 0,
+		0, 0, 719, 723, 3, 142, 70, 0, 720, 722, 3, 144, 71, 0, 721, 720, 1, 0,
+		0, 0, 722, 725, 1, 0, 0, 0, 723, 721, 1, 0, 0, 0, 723, 724, 1, 0, 0, 0,
+		724, 731, 1, 0, 0, 0, 725, 723, 1, 0, 0, 0, 726, 727, 3, 156, 77, 0, 727,
+		728, 3, 140, 69, 0, 728, 729, 3, 156, 77, 0, 729, 731, 1, 0, 0, 0, 730,
+		719, 1, 0, 0, 0, 730, 726, 1, 0, 0, 0, 731, 141, 1, 0, 0, 0, 732, 733,
+		3, 146, 72, 0, 733, 143, 1, 0, 0, 0, 734, 739, 3, 146, 72, 0, 735, 739,
+		3, 148, 73, 0, 736, 739, 3, 154, 76, 0, 737, 739, 3, 152, 75, 0, 738, 734,
+		1, 0, 0, 0, 738, 735, 1, 0, 0, 0, 738, 736, 1, 0, 0, 0, 738, 737, 1, 0,
+		0, 0, 739, 145, 1, 0, 0, 0, 740, 741, 7, 29, 0, 0, 741, 147, 1, 0, 0, 0,
+		742, 743, 7, 30, 0, 0, 743, 149, 1, 0, 0, 0, 744, 745, 5, 35, 0, 0, 745,
+		151, 1, 0, 0, 0, 746, 747, 5, 36, 0, 0, 747, 153, 1, 0, 0, 0, 748, 749,
+		5, 95, 0, 0, 749, 155, 1, 0, 0, 0, 750, 751, 5, 34, 0, 0, 751, 157, 1,
+		0, 0, 0, 752, 753, 5, 37, 0, 0, 753, 159, 1, 0, 0, 0, 754, 755, 5, 38,
+		0, 0, 755, 161, 1, 0, 0, 0, 756, 757, 5, 39, 0, 0, 757, 163, 1, 0, 0, 0,
+		758, 759, 5, 40, 0, 0, 759, 165, 1, 0, 0, 0, 760, 761, 5, 41, 0, 0, 761,
+		167, 1, 0, 0, 0, 762, 763, 5, 91, 0, 0, 763, 169, 1, 0, 0, 0, 764, 765,
+		5, 93, 0, 0, 765, 171, 1, 0, 0, 0, 766, 767, 5, 42, 0, 0, 767, 173, 1,
+		0, 0, 0, 768, 769, 5, 43, 0, 0, 769, 175, 1, 0, 0, 0, 770, 771, 5, 44,
+		0, 0, 771, 177, 1, 0, 0, 0, 772, 773, 5, 45, 0, 0, 773, 179, 1, 0, 0, 0,
+		774, 775, 5, 46, 0, 0, 775, 181, 1, 0, 0, 0, 776, 777, 5, 47, 0, 0, 777,
+		183, 1, 0, 0, 0, 778, 779, 5, 94, 0, 0, 779, 185, 1, 0, 0, 0, 780, 781,
+		5, 124, 0, 0, 781, 782, 5, 124, 0, 0, 782, 187, 1, 0, 0, 0, 783, 784, 5,
+		58, 0, 0, 784, 189, 1, 0, 0, 0, 785, 786, 5, 59, 0, 0, 786, 191, 1, 0,
+		0, 0, 787, 788, 5, 63, 0, 0, 788, 193, 1, 0, 0, 0, 789, 790, 5, 124, 0,
+		0, 790, 195, 1, 0, 0, 0, 791, 792, 2, 48, 49, 0, 792, 197, 1, 0, 0, 0,
+		793, 801, 3, 148, 73, 0, 794, 801, 3, 2, 0, 0, 795, 801, 3, 4, 1, 0, 796,
+		801, 3, 6, 2, 0, 797, 801, 3, 8, 3, 0, 798, 801, 3, 10, 4, 0, 799, 801,
+		3, 12, 5, 0, 800, 793, 1, 0, 0, 0, 800, 794, 1, 0, 0, 0, 800, 795, 1, 0,
+		0, 0, 800, 796, 1, 0, 0, 0, 800, 797, 1, 0, 0, 0, 800, 798, 1, 0, 0, 0,
+		800, 799, 1, 0, 0, 0, 801, 199, 1, 0, 0, 0, 802, 805, 3, 204, 101, 0, 803,
+		805, 3, 206, 102, 0, 804, 802, 1, 0, 0, 0, 804, 803, 1, 0, 0, 0, 805, 201,
+		1, 0, 0, 0, 806, 808, 3, 216, 107, 0, 807, 806, 1, 0, 0, 0, 807, 808, 1,
+		0, 0, 0, 808, 809, 1, 0, 0, 0, 809, 812, 3, 204, 101, 0, 810, 812, 3, 206,
+		102, 0, 811, 807, 1, 0, 0, 0, 811, 810, 1, 0, 0, 0, 812, 203, 1, 0, 0,
+		0, 813, 818, 3, 214, 106, 0, 814, 816, 3, 180, 89, 0, 815, 817, 3, 214,
+		106, 0, 816, 815, 1, 0, 0, 0, 816, 817, 1, 0, 0, 0, 817, 819, 1, 0, 0,
+		0, 818, 814, 1, 0, 0, 0, 818, 819, 1, 0, 0, 0, 819, 824, 1, 0, 0, 0, 820,
+		821, 3, 180, 89, 0, 821, 822, 3, 214, 106, 0, 822, 824, 1, 0, 0, 0, 823,
+		813, 1, 0, 0, 0, 823, 820, 1, 0, 0, 0, 824, 205, 1, 0, 0, 0, 825, 826,
+		3, 208, 103, 0, 826, 827, 7, 4, 0, 0, 827, 828, 3, 210, 104, 0, 828, 207,
+		1, 0, 0, 0, 829, 830, 3, 204, 101, 0, 830, 209, 1, 0, 0, 0, 831, 832, 3,
+		212, 105, 0, 832, 211, 1, 0, 0, 0, 833, 835, 3, 216, 107, 0, 834, 833,
+		1, 0, 0, 0, 834, 835, 1, 0, 0, 0, 835, 836, 1, 0, 0, 0, 836, 837, 3, 214,
+		106, 0, 837, 213, 1, 0, 0, 0, 838, 840, 3, 148, 73, 0, 839, 838, 1, 0,
+		0, 0, 840, 841, 1, 0, 0, 0, 841, 839, 1, 0, 0, 0, 841, 842, 1, 0, 0, 0,
+		842, 215, 1, 0, 0, 0, 843, 846, 3, 174, 86, 0, 844, 846, 3, 178, 88, 0,
+		845, 843, 1, 0, 0, 0, 845, 844, 1, 0, 0, 0, 846, 217, 1, 0, 0, 0, 847,
+		912, 3, 220, 109, 0, 848, 849, 3, 40, 19, 0, 849, 850, 3, 18, 8, 0, 850,
+		851, 3, 26, 12, 0, 851, 852, 3, 10, 4, 0, 852, 853, 3, 38, 18, 0, 853,
+		854, 3, 40, 19, 0, 854, 855, 3, 2, 0, 0, 855, 856, 3, 26, 12, 0, 856, 860,
+		3, 32, 15, 0, 857, 859, 7, 31, 0, 0, 858, 857, 1, 0, 0, 0, 859, 862, 1,
+		0, 0, 0, 860, 858, 1, 0, 0, 0, 860, 861, 1, 0, 0, 0, 861, 863, 1, 0, 0,
+		0, 862, 860, 1, 0, 0, 0, 863, 867, 5, 40, 0, 0, 864, 866, 7, 31, 0, 0,
+		865, 864, 1, 0, 0, 0, 866, 869, 1, 0, 0, 0, 867, 865, 1, 0, 0, 0, 867,
+		868, 1, 0, 0, 0, 868, 870, 1, 0, 0, 0, 869, 867, 1, 0, 0, 0, 870, 871,
+		5, 39, 0, 0, 871, 872, 3, 222, 110, 0, 872, 873, 5, 84, 0, 0, 873, 874,
+		3, 230, 114, 0, 874, 878, 5, 39, 0, 0, 875, 877, 7, 31, 0, 0, 876, 875,
+		1, 0, 0, 0, 877, 880, 1, 0, 0, 0, 878, 876, 1, 0, 0, 0, 878, 879, 1, 0,
+		0, 0, 879, 881, 1, 0, 0, 0, 880, 878, 1, 0, 0, 0, 881, 882, 5, 41, 0, 0,
+		882, 912, 1, 0, 0, 0, 883, 884, 3, 8, 3, 0, 884, 885, 3, 2, 0, 0, 885,
+		886, 3, 40, 19, 0, 886, 890, 3, 10, 4, 0, 887, 889, 7, 31, 0, 0, 888, 887,
+		1, 0, 0, 0, 889, 892, 1, 0, 0, 0, 890, 888, 1, 0, 0, 0, 890, 891, 1, 0,
+		0, 0, 891, 893, 1, 0, 0, 0, 892, 890, 1, 0, 0, 0, 893, 897, 5, 40, 0, 0,
+		894, 896, 7, 31, 0, 0, 895, 894, 1, 0, 0, 0, 896, 899, 1, 0, 0, 0, 897,
+		895, 1, 0, 0, 0, 897, 898, 1, 0, 0, 0, 898, 900, 1, 0, 0, 0, 899, 897,
+		1, 0, 0, 0, 900, 901, 5, 39, 0, 0, 901, 902, 3, 222, 110, 0, 902, 906,
+		5, 39, 0, 0, 903, 905, 7, 31, 0, 0, 904, 903, 1, 0, 0, 0, 905, 908, 1,
+		0, 0, 0, 906, 904, 1, 0, 0, 0, 906, 907, 1, 0, 0, 0, 907, 909, 1, 0, 0,
+		0, 908, 906, 1, 0, 0, 0, 909, 910, 5, 41, 0, 0, 910, 912, 1, 0, 0, 0, 911,
+		847, 1, 0, 0, 0, 911, 848, 1, 0, 0, 0, 911, 883, 1, 0, 0, 0, 912, 219,
+		1, 0, 0, 0, 913, 923, 3, 222, 110, 0, 914, 915, 3, 222, 110, 0, 915, 916,
+		5, 84, 0, 0, 916, 917, 3, 230, 114, 0, 917, 923, 1, 0, 0, 0, 918, 919,
+		3, 240, 119, 0, 919, 920, 3, 164, 81, 0, 920, 921, 3, 166, 82, 0, 921,
+		923, 1, 0, 0, 0, 922, 913, 1, 0, 0, 0, 922, 914, 1, 0, 0, 0, 922, 918,
+		1, 0, 0, 0, 923, 221, 1, 0, 0, 0, 924, 925, 3, 224, 111, 0, 925, 926, 5,
+		45, 0, 0, 926, 927, 3, 226, 112, 0, 927, 928, 5, 45, 0, 0, 928, 929, 3,
+		228, 113, 0, 929, 223, 1, 0, 0, 0, 930, 931, 3, 148, 73, 0, 931, 932, 3,
+		148, 73, 0, 932, 933, 3, 148, 73, 0, 933, 934, 3, 148, 73, 0, 934, 225,
+		1, 0, 0, 0, 935, 936, 3, 148, 73, 0, 936, 937, 3, 148, 73, 0, 937, 227,
+		1, 0, 0, 0, 938, 939, 3, 148, 73, 0, 939, 940, 3, 148, 73, 0, 940, 229,
+		1, 0, 0, 0, 941, 942, 3, 234, 116, 0, 942, 943, 5, 58, 0, 0, 943, 946,
+		3, 236, 117, 0, 944, 945, 5, 58, 0, 0, 945, 947, 3, 238, 118, 0, 946, 944,
+		1, 0, 0, 0, 946, 947, 1, 0, 0, 0, 947, 949, 1, 0, 0, 0, 948, 950, 3, 232,
+		115, 0, 949, 948, 1, 0, 0, 0, 949, 950, 1, 0, 0, 0, 950, 231, 1, 0, 0,
+		0, 951, 958, 5, 90, 0, 0, 952, 953, 3, 216, 107, 0, 953, 954, 3, 234, 116,
+		0, 954, 955, 5, 58, 0, 0, 955, 956, 3, 236, 117, 0, 956, 958, 1, 0, 0,
+		0, 957, 951, 1, 0, 0, 0, 957, 952, 1, 0, 0, 0, 958, 233, 1, 0, 0, 0, 959,
+		960, 3, 148, 73, 0, 960, 961, 3, 148, 73, 0, 961, 235, 1, 0, 0, 0, 962,
+		963, 3, 148, 73, 0, 963, 964, 3, 148, 73, 0, 964, 237, 1, 0, 0, 0, 965,
+		966, 3, 148, 73, 0, 966, 973, 3, 148, 73, 0, 967, 969, 3, 180, 89, 0, 968,
+		970, 3, 148, 73, 0, 969, 968, 1, 0, 0, 0, 970, 971, 1, 0, 0, 0, 971, 969,
+		1, 0, 0, 0, 971, 972, 1, 0, 0, 0, 972, 974, 1, 0, 0, 0, 973, 967, 1, 0,
+		0, 0, 973, 974, 1, 0, 0, 0, 974, 239, 1, 0, 0, 0, 975, 976, 3, 28, 13,
+		0, 976, 977, 3, 30, 14, 0, 977, 978, 3, 46, 22, 0, 978, 241, 1, 0, 0, 0,
+		979, 981, 7, 31, 0, 0, 980, 979, 1, 0, 0, 0, 981, 982, 1, 0, 0, 0, 982,
+		980, 1, 0, 0, 0, 982, 983, 1, 0, 0, 0, 983, 984, 1, 0, 0, 0, 984, 985,
+		6, 120, 2, 0, 985, 243, 1, 0, 0, 0, 986, 987, 5, 39, 0, 0, 987, 988, 1,
+		0, 0, 0, 988, 989, 6, 121, 3, 0, 989, 245, 1, 0, 0, 0, 990, 991, 5, 39,
+		0, 0, 991, 992, 5, 39, 0, 0, 992, 993, 1, 0, 0, 0, 993, 994, 6, 122, 0,
+		0, 994, 247, 1, 0, 0, 0, 995, 996, 8, 32, 0, 0, 996, 997, 1, 0, 0, 0, 997,
+		998, 6, 123, 0, 0, 998, 249, 1, 0, 0, 0, 43, 0, 1, 308, 336, 394, 399,
+		469, 577, 653, 668, 676, 689, 696, 698, 712, 714, 723, 730, 738, 800, 804,
+		807, 811, 816, 818, 823, 834, 841, 845, 860, 867, 878, 890, 897, 906, 911,
+		922, 946, 949, 957, 971, 973, 982, 4, 3, 0, 0, 2, 1, 0, 6, 0, 0, 2, 0,
+		0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -488,72 +606,82 @@ const (
 	CqlLexerIS                        = 15
 	CqlLexerNULL                      = 16
 	CqlLexerIN                        = 17
-	CqlLexerArithmeticOperator        = 18
-	CqlLexerSpatialOperator           = 19
-	CqlLexerDistanceOperator          = 20
-	CqlLexerPOINT                     = 21
-	CqlLexerLINESTRING                = 22
-	CqlLexerPOLYGON                   = 23
-	CqlLexerMULTIPOINT                = 24
-	CqlLexerMULTILINESTRING           = 25
-	CqlLexerMULTIPOLYGON              = 26
-	CqlLexerGEOMETRYCOLLECTION        = 27
-	CqlLexerENVELOPE                  = 28
-	CqlLexerNumericLiteral            = 29
-	CqlLexerIdentifier                = 30
-	CqlLexerIdentifierStart           = 31
-	CqlLexerIdentifierPart            = 32
-	CqlLexerALPHA                     = 33
-	CqlLexerDIGIT                     = 34
-	CqlLexerOCTOTHORP                 = 35
-	CqlLexerDOLLAR                    = 36
-	CqlLexerUNDERSCORE                = 37
-	CqlLexerDOUBLEQUOTE               = 38
-	CqlLexerPERCENT                   = 39
-	CqlLexerAMPERSAND                 = 40
-	CqlLexerQUOTE                     = 41
-	CqlLexerLEFTPAREN                 = 42
-	CqlLexerRIGHTPAREN                = 43
-	CqlLexerLEFTSQUAREBRACKET         = 44
-	CqlLexerRIGHTSQUAREBRACKET        = 45
-	CqlLexerASTERISK                  = 46
-	CqlLexerPLUS                      = 47
-	CqlLexerCOMMA                     = 48
-	CqlLexerMINUS                     = 49
-	CqlLexerPERIOD                    = 50
-	CqlLexerSOLIDUS                   = 51
-	CqlLexerCARET                     = 52
-	CqlLexerCONCAT                    = 53
-	CqlLexerCOLON                     = 54
-	CqlLexerSEMICOLON                 = 55
-	CqlLexerQUESTIONMARK              = 56
-	CqlLexerVERTICALBAR               = 57
-	CqlLexerBIT                       = 58
-	CqlLexerHEXIT                     = 59
-	CqlLexerUnsignedNumericLiteral    = 60
-	CqlLexerSignedNumericLiteral      = 61
-	CqlLexerExactNumericLiteral       = 62
-	CqlLexerApproximateNumericLiteral = 63
-	CqlLexerMantissa                  = 64
-	CqlLexerExponent                  = 65
-	CqlLexerSignedInteger             = 66
-	CqlLexerUnsignedInteger           = 67
-	CqlLexerSign                      = 68
-	CqlLexerTemporalLiteral           = 69
-	CqlLexerInstant                   = 70
-	CqlLexerFullDate                  = 71
-	CqlLexerDateYear                  = 72
-	CqlLexerDateMonth                 = 73
-	CqlLexerDateDay                   = 74
-	CqlLexerUtcTime                   = 75
-	CqlLexerTimeZoneOffset            = 76
-	CqlLexerTimeHour                  = 77
-	CqlLexerTimeMinute                = 78
-	CqlLexerTimeSecond                = 79
-	CqlLexerNOW                       = 80
-	CqlLexerWS                        = 81
-	CqlLexerCharacterStringLiteral    = 82
-	CqlLexerQuotedQuote               = 83
+	CqlLexerUNKNOWN                   = 18
+	CqlLexerArithmeticOperator        = 19
+	CqlLexerSpatialOperator           = 20
+	CqlLexerDistanceOperator          = 21
+	CqlLexerPOINT                     = 22
+	CqlLexerLINESTRING                = 23
+	CqlLexerPOLYGON                   = 24
+	CqlLexerMULTIPOINT                = 25
+	CqlLexerMULTILINESTRING           = 26
+	CqlLexerMULTIPOLYGON              = 27
+	CqlLexerGEOMETRYCOLLECTION        = 28
+	CqlLexerENVELOPE                  = 29
+	CqlLexerCIRCULARSTRING            = 30
+	CqlLexerCOMPOUNDCURVE             = 31
+	CqlLexerCURVEPOLYGON              = 32
+	CqlLexerMULTICURVE                = 33
+	CqlLexerMULTISURFACE              = 34
+	CqlLexerEMPTY                     = 35
+	CqlLexerNumericLiteral            = 36
+	CqlLexerEwktSridPrefix            = 37
+	CqlLexerWkbHexLiteral             = 38
+	CqlLexerGeoJsonLiteral            = 39
+	CqlLexerIdentifier                = 40
+	CqlLexerIdentifierStart           = 41
+	CqlLexerIdentifierPart            = 42
+	CqlLexerALPHA                     = 43
+	CqlLexerDIGIT                     = 44
+	CqlLexerOCTOTHORP                 = 45
+	CqlLexerDOLLAR                    = 46
+	CqlLexerUNDERSCORE                = 47
+	CqlLexerDOUBLEQUOTE               = 48
+	CqlLexerPERCENT                   = 49
+	CqlLexerAMPERSAND                 = 50
+	CqlLexerQUOTE                     = 51
+	CqlLexerLEFTPAREN                 = 52
+	CqlLexerRIGHTPAREN                = 53
+	CqlLexerLEFTSQUAREBRACKET         = 54
+	CqlLexerRIGHTSQUAREBRACKET        = 55
+	CqlLexerASTERISK                  = 56
+	CqlLexerPLUS                      = 57
+	CqlLexerCOMMA                     = 58
+	CqlLexerMINUS                     = 59
+	CqlLexerPERIOD                    = 60
+	CqlLexerSOLIDUS                   = 61
+	CqlLexerCARET                     = 62
+	CqlLexerCONCAT                    = 63
+	CqlLexerCOLON                     = 64
+	CqlLexerSEMICOLON                 = 65
+	CqlLexerQUESTIONMARK              = 66
+	CqlLexerVERTICALBAR               = 67
+	CqlLexerBIT                       = 68
+	CqlLexerHEXIT                     = 69
+	CqlLexerUnsignedNumericLiteral    = 70
+	CqlLexerSignedNumericLiteral      = 71
+	CqlLexerExactNumericLiteral       = 72
+	CqlLexerApproximateNumericLiteral = 73
+	CqlLexerMantissa                  = 74
+	CqlLexerExponent                  = 75
+	CqlLexerSignedInteger             = 76
+	CqlLexerUnsignedInteger           = 77
+	CqlLexerSign                      = 78
+	CqlLexerTemporalLiteral           = 79
+	CqlLexerInstant                   = 80
+	CqlLexerFullDate                  = 81
+	CqlLexerDateYear                  = 82
+	CqlLexerDateMonth                 = 83
+	CqlLexerDateDay                   = 84
+	CqlLexerUtcTime                   = 85
+	CqlLexerTimeZoneOffset            = 86
+	CqlLexerTimeHour                  = 87
+	CqlLexerTimeMinute                = 88
+	CqlLexerTimeSecond                = 89
+	CqlLexerNOW                       = 90
+	CqlLexerWS                        = 91
+	CqlLexerCharacterStringLiteral    = 92
+	CqlLexerQuotedQuote               = 93
 )
 
 // CqlLexerSTR is the CqlLexer mode.
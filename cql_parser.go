@@ -34,17 +34,20 @@ func cqlparserParserInit() {
 	staticData.LiteralNames = []string{
 		"", "", "'<'", "'='", "'>'", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "'#'", "'$'", "'_'", "'\"'", "'%'", "'&'", "", "'('", "')'",
-		"'['", "']'", "'*'", "'+'", "','", "'-'", "'.'", "'/'", "'^'", "'||'",
-		"':'", "';'", "'?'", "'|'", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "''''",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "'#'", "'$'", "'_'",
+		"'\"'", "'%'", "'&'", "", "'('", "')'", "'['", "']'", "'*'", "'+'",
+		"','", "'-'", "'.'", "'/'", "'^'", "'||'", "':'", "';'", "'?'", "'|'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "''''",
 	}
 	staticData.SymbolicNames = []string{
 		"", "ComparisonOperator", "LT", "EQ", "GT", "NEQ", "GTEQ", "LTEQ", "BooleanLiteral",
 		"AND", "OR", "NOT", "LIKE", "ILIKE", "BETWEEN", "IS", "NULL", "IN",
-		"ArithmeticOperator", "SpatialOperator", "DistanceOperator", "POINT",
-		"LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON",
-		"GEOMETRYCOLLECTION", "ENVELOPE", "NumericLiteral", "Identifier", "IdentifierStart",
+		"UNKNOWN", "ArithmeticOperator", "SpatialOperator", "DistanceOperator",
+		"POINT", "LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING", "MULTIPOLYGON",
+		"GEOMETRYCOLLECTION", "ENVELOPE", "CIRCULARSTRING", "COMPOUNDCURVE",
+		"CURVEPOLYGON", "MULTICURVE", "MULTISURFACE", "EMPTY", "NumericLiteral",
+		"EwktSridPrefix", "WkbHexLiteral", "GeoJsonLiteral", "Identifier", "IdentifierStart",
 		"IdentifierPart", "ALPHA", "DIGIT", "OCTOTHORP", "DOLLAR", "UNDERSCORE",
 		"DOUBLEQUOTE", "PERCENT", "AMPERSAND", "QUOTE", "LEFTPAREN", "RIGHTPAREN",
 		"LEFTSQUAREBRACKET", "RIGHTSQUAREBRACKET", "ASTERISK", "PLUS", "COMMA",
@@ -57,152 +60,255 @@ func cqlparserParserInit() {
 		"NOW", "WS", "CharacterStringLiteral", "QuotedQuote",
 	}
 	staticData.RuleNames = []string{
-		"cqlFilter", "booleanExpression", "booleanTerm", "predicate", "comparisonPredicate",
-		"binaryComparisonPredicate", "isLikePredicate", "isBetweenPredicate",
-		"isInListPredicate", "isNullPredicate", "scalarExpression", "scalarValue",
-		"propertyName", "characterLiteral", "numericLiteral", "booleanLiteral",
-		"temporalLiteral", "spatialPredicate", "distancePredicate", "geomExpression",
-		"geomLiteral", "point", "pointList", "linestring", "polygon", "polygonDef",
-		"multiPoint", "multiLinestring", "multiPolygon", "geometryCollection",
-		"envelope", "coordList", "coordinate",
+		"cqlFilter", "booleanExpression", "booleanTerm", "truthValue", "predicate",
+		"comparisonPredicate", "binaryComparisonPredicate", "isLikePredicate",
+		"isBetweenPredicate", "isInListPredicate", "isNullPredicate", "scalarExpression",
+		"scalarValue", "propertyName", "function", "characterLiteral", "numericLiteral",
+		"booleanLiteral", "temporalLiteral", "spatialPredicate", "distancePredicate",
+		"geomExpression", "geomLiteral", "point", "pointList", "linestring",
+		"polygon", "polygonDef", "multiPoint", "multiLinestring", "multiPolygon",
+		"geometryCollection", "circularString", "compoundCurve", "curveMember",
+		"curvePolygon", "curveRing", "multiCurve", "multiSurface", "surfaceMember",
+		"envelope", "coordList", "coordinate", "dimension",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 83, 320, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 93, 519, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
 		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
 		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 3, 1, 78, 8, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 5, 1, 86,
-		8, 1, 10, 1, 12, 1, 89, 9, 1, 1, 2, 1, 2, 3, 2, 93, 8, 2, 1, 3, 1, 3, 1,
-		3, 3, 3, 98, 8, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 3, 4, 105, 8, 4, 1, 5,
-		1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 3, 6, 113, 8, 6, 1, 6, 1, 6, 1, 6, 1, 7,
-		1, 7, 3, 7, 120, 8, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 3, 8,
-		129, 8, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 5, 8, 136, 8, 8, 10, 8, 12, 8,
-		139, 9, 8, 1, 8, 1, 8, 1, 8, 5, 8, 144, 8, 8, 10, 8, 12, 8, 147, 9, 8,
-		3, 8, 149, 8, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 3, 9, 156, 8, 9, 1, 9, 1,
-		9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 3, 10, 166, 8, 10, 1, 10,
-		1, 10, 1, 10, 5, 10, 171, 8, 10, 10, 10, 12, 10, 174, 9, 10, 1, 11, 1,
-		11, 1, 11, 1, 11, 1, 11, 3, 11, 181, 8, 11, 1, 12, 1, 12, 1, 13, 1, 13,
-		1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18,
-		1, 18, 1, 19, 1, 19, 3, 19, 211, 8, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1,
-		20, 1, 20, 1, 20, 1, 20, 3, 20, 221, 8, 20, 1, 21, 1, 21, 1, 21, 1, 22,
-		1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1,
-		25, 1, 25, 1, 25, 5, 25, 240, 8, 25, 10, 25, 12, 25, 243, 9, 25, 1, 25,
-		1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 5, 26, 252, 8, 26, 10, 26, 12,
-		26, 255, 9, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 5, 27,
-		264, 8, 27, 10, 27, 12, 27, 267, 9, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1,
-		28, 1, 28, 1, 28, 5, 28, 276, 8, 28, 10, 28, 12, 28, 279, 9, 28, 1, 28,
-		1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 5, 29, 288, 8, 29, 10, 29, 12,
-		29, 291, 9, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
-		1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 5, 31, 310,
-		8, 31, 10, 31, 12, 31, 313, 9, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1,
-		32, 0, 2, 2, 20, 33, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26,
-		28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62,
-		64, 0, 1, 1, 0, 12, 13, 324, 0, 66, 1, 0, 0, 0, 2, 77, 1, 0, 0, 0, 4, 92,
-		1, 0, 0, 0, 6, 97, 1, 0, 0, 0, 8, 104, 1, 0, 0, 0, 10, 106, 1, 0, 0, 0,
-		12, 110, 1, 0, 0, 0, 14, 117, 1, 0, 0, 0, 16, 126, 1, 0, 0, 0, 18, 152,
-		1, 0, 0, 0, 20, 165, 1, 0, 0, 0, 22, 180, 1, 0, 0, 0, 24, 182, 1, 0, 0,
-		0, 26, 184, 1, 0, 0, 0, 28, 186, 1, 0, 0, 0, 30, 188, 1, 0, 0, 0, 32, 190,
-		1, 0, 0, 0, 34, 192, 1, 0, 0, 0, 36, 199, 1, 0, 0, 0, 38, 210, 1, 0, 0,
-		0, 40, 220, 1, 0, 0, 0, 42, 222, 1, 0, 0, 0, 44, 225, 1, 0, 0, 0, 46, 229,
-		1, 0, 0, 0, 48, 232, 1, 0, 0, 0, 50, 235, 1, 0, 0, 0, 52, 246, 1, 0, 0,
-		0, 54, 258, 1, 0, 0, 0, 56, 270, 1, 0, 0, 0, 58, 282, 1, 0, 0, 0, 60, 294,
-		1, 0, 0, 0, 62, 305, 1, 0, 0, 0, 64, 316, 1, 0, 0, 0, 66, 67, 3, 2, 1,
-		0, 67, 68, 5, 0, 0, 1, 68, 1, 1, 0, 0, 0, 69, 70, 6, 1, -1, 0, 70, 71,
-		5, 42, 0, 0, 71, 72, 3, 2, 1, 0, 72, 73, 5, 43, 0, 0, 73, 78, 1, 0, 0,
-		0, 74, 75, 5, 11, 0, 0, 75, 78, 3, 2, 1, 2, 76, 78, 3, 4, 2, 0, 77, 69,
-		1, 0, 0, 0, 77, 74, 1, 0, 0, 0, 77, 76, 1, 0, 0, 0, 78, 87, 1, 0, 0, 0,
-		79, 80, 10, 4, 0, 0, 80, 81, 5, 9, 0, 0, 81, 86, 3, 2, 1, 5, 82, 83, 10,
-		3, 0, 0, 83, 84, 5, 10, 0, 0, 84, 86, 3, 2, 1, 4, 85, 79, 1, 0, 0, 0, 85,
-		82, 1, 0, 0, 0, 86, 89, 1, 0, 0, 0, 87, 85, 1, 0, 0, 0, 87, 88, 1, 0, 0,
-		0, 88, 3, 1, 0, 0, 0, 89, 87, 1, 0, 0, 0, 90, 93, 3, 6, 3, 0, 91, 93, 3,
-		30, 15, 0, 92, 90, 1, 0, 0, 0, 92, 91, 1, 0, 0, 0, 93, 5, 1, 0, 0, 0, 94,
-		98, 3, 8, 4, 0, 95, 98, 3, 34, 17, 0, 96, 98, 3, 36, 18, 0, 97, 94, 1,
-		0, 0, 0, 97, 95, 1, 0, 0, 0, 97, 96, 1, 0, 0, 0, 98, 7, 1, 0, 0, 0, 99,
-		105, 3, 10, 5, 0, 100, 105, 3, 12, 6, 0, 101, 105, 3, 14, 7, 0, 102, 105,
-		3, 16, 8, 0, 103, 105, 3, 18, 9, 0, 104, 99, 1, 0, 0, 0, 104, 100, 1, 0,
-		0, 0, 104, 101, 1, 0, 0, 0, 104, 102, 1, 0, 0, 0, 104, 103, 1, 0, 0, 0,
-		105, 9, 1, 0, 0, 0, 106, 107, 3, 20, 10, 0, 107, 108, 5, 1, 0, 0, 108,
-		109, 3, 20, 10, 0, 109, 11, 1, 0, 0, 0, 110, 112, 3, 24, 12, 0, 111, 113,
-		5, 11, 0, 0, 112, 111, 1, 0, 0, 0, 112, 113, 1, 0, 0, 0, 113, 114, 1, 0,
-		0, 0, 114, 115, 7, 0, 0, 0, 115, 116, 3, 26, 13, 0, 116, 13, 1, 0, 0, 0,
-		117, 119, 3, 20, 10, 0, 118, 120, 5, 11, 0, 0, 119, 118, 1, 0, 0, 0, 119,
-		120, 1, 0, 0, 0, 120, 121, 1, 0, 0, 0, 121, 122, 5, 14, 0, 0, 122, 123,
-		3, 20, 10, 0, 123, 124, 5, 9, 0, 0, 124, 125, 3, 20, 10, 0, 125, 15, 1,
-		0, 0, 0, 126, 128, 3, 24, 12, 0, 127, 129, 5, 11, 0, 0, 128, 127, 1, 0,
-		0, 0, 128, 129, 1, 0, 0, 0, 129, 130, 1, 0, 0, 0, 130, 131, 5, 17, 0, 0,
-		131, 148, 5, 42, 0, 0, 132, 137, 3, 26, 13, 0, 133, 134, 5, 48, 0, 0, 134,
-		136, 3, 26, 13, 0, 135, 133, 1, 0, 0, 0, 136, 139, 1, 0, 0, 0, 137, 135,
-		1, 0, 0, 0, 137, 138, 1, 0, 0, 0, 138, 149, 1, 0, 0, 0, 139, 137, 1, 0,
-		0, 0, 140, 145, 3, 28, 14, 0, 141, 142, 5, 48, 0, 0, 142, 144, 3, 28, 14,
-		0, 143, 141, 1, 0, 0, 0, 144, 147, 1, 0, 0, 0, 145, 143, 1, 0, 0, 0, 145,
-		146, 1, 0, 0, 0, 146, 149, 1, 0, 0, 0, 147, 145, 1, 0, 0, 0, 148, 132,
-		1, 0, 0, 0, 148, 140, 1, 0, 0, 0, 149, 150, 1, 0, 0, 0, 150, 151, 5, 43,
-		0, 0, 151, 17, 1, 0, 0, 0, 152, 153, 3, 24, 12, 0, 153, 155, 5, 15, 0,
-		0, 154, 156, 5, 11, 0, 0, 155, 154, 1, 0, 0, 0, 155, 156, 1, 0, 0, 0, 156,
-		157, 1, 0, 0, 0, 157, 158, 5, 16, 0, 0, 158, 19, 1, 0, 0, 0, 159, 160,
-		6, 10, -1, 0, 160, 166, 3, 22, 11, 0, 161, 162, 5, 42, 0, 0, 162, 163,
-		3, 20, 10, 0, 163, 164, 5, 43, 0, 0, 164, 166, 1, 0, 0, 0, 165, 159, 1,
-		0, 0, 0, 165, 161, 1, 0, 0, 0, 166, 172, 1, 0, 0, 0, 167, 168, 10, 1, 0,
-		0, 168, 169, 5, 18, 0, 0, 169, 171, 3, 20, 10, 2, 170, 167, 1, 0, 0, 0,
-		171, 174, 1, 0, 0, 0, 172, 170, 1, 0, 0, 0, 172, 173, 1, 0, 0, 0, 173,
-		21, 1, 0, 0, 0, 174, 172, 1, 0, 0, 0, 175, 181, 3, 24, 12, 0, 176, 181,
-		3, 26, 13, 0, 177, 181, 3, 28, 14, 0, 178, 181, 3, 30, 15, 0, 179, 181,
-		3, 32, 16, 0, 180, 175, 1, 0, 0, 0, 180, 176, 1, 0, 0, 0, 180, 177, 1,
-		0, 0, 0, 180, 178, 1, 0, 0, 0, 180, 179, 1, 0, 0, 0, 181, 23, 1, 0, 0,
-		0, 182, 183, 5, 30, 0, 0, 183, 25, 1, 0, 0, 0, 184, 185, 5, 82, 0, 0, 185,
-		27, 1, 0, 0, 0, 186, 187, 5, 29, 0, 0, 187, 29, 1, 0, 0, 0, 188, 189, 5,
-		8, 0, 0, 189, 31, 1, 0, 0, 0, 190, 191, 5, 69, 0, 0, 191, 33, 1, 0, 0,
-		0, 192, 193, 5, 19, 0, 0, 193, 194, 5, 42, 0, 0, 194, 195, 3, 38, 19, 0,
-		195, 196, 5, 48, 0, 0, 196, 197, 3, 38, 19, 0, 197, 198, 5, 43, 0, 0, 198,
-		35, 1, 0, 0, 0, 199, 200, 5, 20, 0, 0, 200, 201, 5, 42, 0, 0, 201, 202,
-		3, 38, 19, 0, 202, 203, 5, 48, 0, 0, 203, 204, 3, 38, 19, 0, 204, 205,
-		5, 48, 0, 0, 205, 206, 5, 29, 0, 0, 206, 207, 5, 43, 0, 0, 207, 37, 1,
-		0, 0, 0, 208, 211, 3, 24, 12, 0, 209, 211, 3, 40, 20, 0, 210, 208, 1, 0,
-		0, 0, 210, 209, 1, 0, 0, 0, 211, 39, 1, 0, 0, 0, 212, 221, 3, 42, 21, 0,
-		213, 221, 3, 46, 23, 0, 214, 221, 3, 48, 24, 0, 215, 221, 3, 52, 26, 0,
-		216, 221, 3, 54, 27, 0, 217, 221, 3, 56, 28, 0, 218, 221, 3, 58, 29, 0,
-		219, 221, 3, 60, 30, 0, 220, 212, 1, 0, 0, 0, 220, 213, 1, 0, 0, 0, 220,
-		214, 1, 0, 0, 0, 220, 215, 1, 0, 0, 0, 220, 216, 1, 0, 0, 0, 220, 217,
-		1, 0, 0, 0, 220, 218, 1, 0, 0, 0, 220, 219, 1, 0, 0, 0, 221, 41, 1, 0,
-		0, 0, 222, 223, 5, 21, 0, 0, 223, 224, 3, 44, 22, 0, 224, 43, 1, 0, 0,
-		0, 225, 226, 5, 42, 0, 0, 226, 227, 3, 64, 32, 0, 227, 228, 5, 43, 0, 0,
-		228, 45, 1, 0, 0, 0, 229, 230, 5, 22, 0, 0, 230, 231, 3, 62, 31, 0, 231,
-		47, 1, 0, 0, 0, 232, 233, 5, 23, 0, 0, 233, 234, 3, 50, 25, 0, 234, 49,
-		1, 0, 0, 0, 235, 236, 5, 42, 0, 0, 236, 241, 3, 62, 31, 0, 237, 238, 5,
-		48, 0, 0, 238, 240, 3, 62, 31, 0, 239, 237, 1, 0, 0, 0, 240, 243, 1, 0,
-		0, 0, 241, 239, 1, 0, 0, 0, 241, 242, 1, 0, 0, 0, 242, 244, 1, 0, 0, 0,
-		243, 241, 1, 0, 0, 0, 244, 245, 5, 43, 0, 0, 245, 51, 1, 0, 0, 0, 246,
-		247, 5, 24, 0, 0, 247, 248, 5, 42, 0, 0, 248, 253, 3, 44, 22, 0, 249, 250,
-		5, 48, 0, 0, 250, 252, 3, 44, 22, 0, 251, 249, 1, 0, 0, 0, 252, 255, 1,
-		0, 0, 0, 253, 251, 1, 0, 0, 0, 253, 254, 1, 0, 0, 0, 254, 256, 1, 0, 0,
-		0, 255, 253, 1, 0, 0, 0, 256, 257, 5, 43, 0, 0, 257, 53, 1, 0, 0, 0, 258,
-		259, 5, 25, 0, 0, 259, 260, 5, 42, 0, 0, 260, 265, 3, 62, 31, 0, 261, 262,
-		5, 48, 0, 0, 262, 264, 3, 62, 31, 0, 263, 261, 1, 0, 0, 0, 264, 267, 1,
-		0, 0, 0, 265, 263, 1, 0, 0, 0, 265, 266, 1, 0, 0, 0, 266, 268, 1, 0, 0,
-		0, 267, 265, 1, 0, 0, 0, 268, 269, 5, 43, 0, 0, 269, 55, 1, 0, 0, 0, 270,
-		271, 5, 26, 0, 0, 271, 272, 5, 42, 0, 0, 272, 277, 3, 50, 25, 0, 273, 274,
-		5, 48, 0, 0, 274, 276, 3, 50, 25, 0, 275, 273, 1, 0, 0, 0, 276, 279, 1,
-		0, 0, 0, 277, 275, 1, 0, 0, 0, 277, 278, 1, 0, 0, 0, 278, 280, 1, 0, 0,
-		0, 279, 277, 1, 0, 0, 0, 280, 281, 5, 43, 0, 0, 281, 57, 1, 0, 0, 0, 282,
-		283, 5, 27, 0, 0, 283, 284, 5, 42, 0, 0, 284, 289, 3, 40, 20, 0, 285, 286,
-		5, 48, 0, 0, 286, 288, 3, 40, 20, 0, 287, 285, 1, 0, 0, 0, 288, 291, 1,
-		0, 0, 0, 289, 287, 1, 0, 0, 0, 289, 290, 1, 0, 0, 0, 290, 292, 1, 0, 0,
-		0, 291, 289, 1, 0, 0, 0, 292, 293, 5, 43, 0, 0, 293, 59, 1, 0, 0, 0, 294,
-		295, 5, 28, 0, 0, 295, 296, 5, 42, 0, 0, 296, 297, 5, 29, 0, 0, 297, 298,
-		5, 48, 0, 0, 298, 299, 5, 29, 0, 0, 299, 300, 5, 48, 0, 0, 300, 301, 5,
-		29, 0, 0, 301, 302, 5, 48, 0, 0, 302, 303, 5, 29, 0, 0, 303, 304, 5, 43,
-		0, 0, 304, 61, 1, 0, 0, 0, 305, 306, 5, 42, 0, 0, 306, 311, 3, 64, 32,
-		0, 307, 308, 5, 48, 0, 0, 308, 310, 3, 64, 32, 0, 309, 307, 1, 0, 0, 0,
-		310, 313, 1, 0, 0, 0, 311, 309, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0, 312,
-		314, 1, 0, 0, 0, 313, 311, 1, 0, 0, 0, 314, 315, 5, 43, 0, 0, 315, 63,
-		1, 0, 0, 0, 316, 317, 5, 29, 0, 0, 317, 318, 5, 29, 0, 0, 318, 65, 1, 0,
-		0, 0, 24, 77, 85, 87, 92, 97, 104, 112, 119, 128, 137, 145, 148, 155, 165,
-		172, 180, 210, 220, 241, 253, 265, 277, 289, 311,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
+		42, 7, 42, 2, 43, 7, 43, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 3, 1, 98, 8, 1, 1, 1, 3, 1, 101, 8, 1, 1, 1, 1, 1, 1, 1, 3, 1, 106,
+		8, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 5, 1, 114, 8, 1, 10, 1, 12, 1,
+		117, 9, 1, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 123, 8, 2, 1, 2, 1, 2, 3, 2, 127,
+		8, 2, 1, 2, 3, 2, 130, 8, 2, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 3, 4, 137, 8,
+		4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 3, 5, 144, 8, 5, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 7, 1, 7, 3, 7, 152, 8, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 3, 8, 159,
+		8, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 3, 9, 168, 8, 9, 1, 9,
+		1, 9, 1, 9, 1, 9, 1, 9, 5, 9, 175, 8, 9, 10, 9, 12, 9, 178, 9, 9, 1, 9,
+		1, 9, 1, 10, 1, 10, 1, 10, 3, 10, 185, 8, 10, 1, 10, 1, 10, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 195, 8, 11, 1, 11, 1, 11, 1, 11, 5,
+		11, 200, 8, 11, 10, 11, 12, 11, 203, 9, 11, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 3, 12, 211, 8, 12, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1,
+		14, 1, 14, 5, 14, 220, 8, 14, 10, 14, 12, 14, 223, 9, 14, 3, 14, 225, 8,
+		14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 16, 1, 16, 1, 17, 1, 17, 1, 18, 1, 18,
+		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1,
+		20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 3, 21, 255, 8, 21,
+		1, 21, 1, 21, 1, 21, 3, 21, 260, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1,
+		22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 3, 22, 275,
+		8, 22, 1, 23, 1, 23, 3, 23, 279, 8, 23, 1, 23, 1, 23, 3, 23, 283, 8, 23,
+		1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 3, 25, 291, 8, 25, 1, 25, 1,
+		25, 3, 25, 295, 8, 25, 1, 26, 1, 26, 3, 26, 299, 8, 26, 1, 26, 1, 26, 3,
+		26, 303, 8, 26, 1, 27, 1, 27, 1, 27, 1, 27, 5, 27, 309, 8, 27, 10, 27,
+		12, 27, 312, 9, 27, 1, 27, 1, 27, 1, 28, 1, 28, 3, 28, 318, 8, 28, 1, 28,
+		1, 28, 1, 28, 1, 28, 5, 28, 324, 8, 28, 10, 28, 12, 28, 327, 9, 28, 1,
+		28, 1, 28, 1, 28, 3, 28, 332, 8, 28, 1, 29, 1, 29, 3, 29, 336, 8, 29, 1,
+		29, 1, 29, 1, 29, 1, 29, 5, 29, 342, 8, 29, 10, 29, 12, 29, 345, 9, 29,
+		1, 29, 1, 29, 1, 29, 3, 29, 350, 8, 29, 1, 30, 1, 30, 3, 30, 354, 8, 30,
+		1, 30, 1, 30, 1, 30, 1, 30, 5, 30, 360, 8, 30, 10, 30, 12, 30, 363, 9,
+		30, 1, 30, 1, 30, 1, 30, 3, 30, 368, 8, 30, 1, 31, 1, 31, 3, 31, 372, 8,
+		31, 1, 31, 1, 31, 1, 31, 1, 31, 5, 31, 378, 8, 31, 10, 31, 12, 31, 381,
+		9, 31, 1, 31, 1, 31, 1, 31, 3, 31, 386, 8, 31, 1, 32, 1, 32, 3, 32, 390,
+		8, 32, 1, 32, 1, 32, 3, 32, 394, 8, 32, 1, 33, 1, 33, 3, 33, 398, 8, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 5, 33, 404, 8, 33, 10, 33, 12, 33, 407, 9,
+		33, 1, 33, 1, 33, 1, 33, 3, 33, 412, 8, 33, 1, 34, 1, 34, 3, 34, 416, 8,
+		34, 1, 35, 1, 35, 3, 35, 420, 8, 35, 1, 35, 1, 35, 1, 35, 1, 35, 5, 35,
+		426, 8, 35, 10, 35, 12, 35, 429, 9, 35, 1, 35, 1, 35, 1, 35, 3, 35, 434,
+		8, 35, 1, 36, 1, 36, 1, 36, 3, 36, 439, 8, 36, 1, 37, 1, 37, 3, 37, 443,
+		8, 37, 1, 37, 1, 37, 1, 37, 1, 37, 5, 37, 449, 8, 37, 10, 37, 12, 37, 452,
+		9, 37, 1, 37, 1, 37, 1, 37, 3, 37, 457, 8, 37, 1, 38, 1, 38, 3, 38, 461,
+		8, 38, 1, 38, 1, 38, 1, 38, 1, 38, 5, 38, 467, 8, 38, 10, 38, 12, 38, 470,
+		9, 38, 1, 38, 1, 38, 1, 38, 3, 38, 475, 8, 38, 1, 39, 1, 39, 3, 39, 479,
+		8, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 1, 40, 1, 40, 3, 40, 494, 8, 40, 1, 40, 1, 40, 1, 41, 1, 41,
+		1, 41, 1, 41, 5, 41, 502, 8, 41, 10, 41, 12, 41, 505, 9, 41, 1, 41, 1,
+		41, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 513, 8, 42, 3, 42, 515, 8, 42, 1,
+		43, 1, 43, 1, 43, 0, 2, 2, 22, 44, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20,
+		22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56,
+		58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 0, 4, 2, 0,
+		8, 8, 18, 18, 1, 0, 12, 13, 3, 0, 18, 18, 29, 35, 40, 40, 2, 0, 36, 36,
+		38, 38, 562, 0, 88, 1, 0, 0, 0, 2, 105, 1, 0, 0, 0, 4, 122, 1, 0, 0, 0,
+		6, 131, 1, 0, 0, 0, 8, 136, 1, 0, 0, 0, 10, 143, 1, 0, 0, 0, 12, 145, 1,
+		0, 0, 0, 14, 149, 1, 0, 0, 0, 16, 156, 1, 0, 0, 0, 18, 165, 1, 0, 0, 0,
+		20, 181, 1, 0, 0, 0, 22, 194, 1, 0, 0, 0, 24, 210, 1, 0, 0, 0, 26, 212,
+		1, 0, 0, 0, 28, 214, 1, 0, 0, 0, 30, 228, 1, 0, 0, 0, 32, 230, 1, 0, 0,
+		0, 34, 232, 1, 0, 0, 0, 36, 234, 1, 0, 0, 0, 38, 236, 1, 0, 0, 0, 40, 243,
+		1, 0, 0, 0, 42, 259, 1, 0, 0, 0, 44, 274, 1, 0, 0, 0, 46, 276, 1, 0, 0,
+		0, 48, 284, 1, 0, 0, 0, 50, 288, 1, 0, 0, 0, 52, 296, 1, 0, 0, 0, 54, 304,
+		1, 0, 0, 0, 56, 315, 1, 0, 0, 0, 58, 333, 1, 0, 0, 0, 60, 351, 1, 0, 0,
+		0, 62, 369, 1, 0, 0, 0, 64, 387, 1, 0, 0, 0, 66, 395, 1, 0, 0, 0, 68, 415,
+		1, 0, 0, 0, 70, 417, 1, 0, 0, 0, 72, 438, 1, 0, 0, 0, 74, 440, 1, 0, 0,
+		0, 76, 458, 1, 0, 0, 0, 78, 478, 1, 0, 0, 0, 80, 480, 1, 0, 0, 0, 82, 497,
+		1, 0, 0, 0, 84, 508, 1, 0, 0, 0, 86, 516, 1, 0, 0, 0, 88, 89, 3, 2, 1,
+		0, 89, 90, 5, 0, 0, 1, 90, 1, 1, 0, 0, 0, 91, 92, 6, 1, -1, 0, 92, 93,
+		5, 52, 0, 0, 93, 94, 3, 2, 1, 0, 94, 100, 5, 53, 0, 0, 95, 97, 5, 15, 0,
+		0, 96, 98, 5, 11, 0, 0, 97, 96, 1, 0, 0, 0, 97, 98, 1, 0, 0, 0, 98, 99,
+		1, 0, 0, 0, 99, 101, 3, 6, 3, 0, 100, 95, 1, 0, 0, 0, 100, 101, 1, 0, 0,
+		0, 101, 106, 1, 0, 0, 0, 102, 103, 5, 11, 0, 0, 103, 106, 3, 2, 1, 2, 104,
+		106, 3, 4, 2, 0, 105, 91, 1, 0, 0, 0, 105, 102, 1, 0, 0, 0, 105, 104, 1,
+		0, 0, 0, 106, 115, 1, 0, 0, 0, 107, 108, 10, 4, 0, 0, 108, 109, 5, 9, 0,
+		0, 109, 114, 3, 2, 1, 5, 110, 111, 10, 3, 0, 0, 111, 112, 5, 10, 0, 0,
+		112, 114, 3, 2, 1, 4, 113, 107, 1, 0, 0, 0, 113, 110, 1, 0, 0, 0, 114,
+		117, 1, 0, 0, 0, 115, 113, 1, 0, 0, 0, 115, 116, 1, 0, 0, 0, 116, 3, 1,
+		0, 0, 0, 117, 115, 1, 0, 0, 0, 118, 123, 3, 8, 4, 0, 119, 123, 3, 34, 17,
+		0, 120, 123, 3, 26, 13, 0, 121, 123, 3, 28, 14, 0, 122, 118, 1, 0, 0, 0,
+		122, 119, 1, 0, 0, 0, 122, 120, 1, 0, 0, 0, 122, 121, 1, 0, 0, 0, 123,
+		129, 1, 0, 0, 0, 124, 126, 5, 15, 0, 0, 125, 127, 5, 11, 0, 0, 126, 125,
+		1, 0, 0, 0, 126, 127, 1, 0, 0, 0, 127, 128, 1, 0, 0, 0, 128, 130, 3, 6,
+		3, 0, 129, 124, 1, 0, 0, 0, 129, 130, 1, 0, 0, 0, 130, 5, 1, 0, 0, 0, 131,
+		132, 7, 0, 0, 0, 132, 7, 1, 0, 0, 0, 133, 137, 3, 10, 5, 0, 134, 137, 3,
+		38, 19, 0, 135, 137, 3, 40, 20, 0, 136, 133, 1, 0, 0, 0, 136, 134, 1, 0,
+		0, 0, 136, 135, 1, 0, 0, 0, 137, 9, 1, 0, 0, 0, 138, 144, 3, 12, 6, 0,
+		139, 144, 3, 14, 7, 0, 140, 144, 3, 16, 8, 0, 141, 144, 3, 18, 9, 0, 142,
+		144, 3, 20, 10, 0, 143, 138, 1, 0, 0, 0, 143, 139, 1, 0, 0, 0, 143, 140,
+		1, 0, 0, 0, 143, 141, 1, 0, 0, 0, 143, 142, 1, 0, 0, 0, 144, 11, 1, 0,
+		0, 0, 145, 146, 3, 22, 11, 0, 146, 147, 5, 1, 0, 0, 147, 148, 3, 22, 11,
+		0, 148, 13, 1, 0, 0, 0, 149, 151, 3, 22, 11, 0, 150, 152, 5, 11, 0, 0,
+		151, 150, 1, 0, 0, 0, 151, 152, 1, 0, 0, 0, 152, 153, 1, 0, 0, 0, 153,
+		154, 7, 1, 0, 0, 154, 155, 3, 22, 11, 0, 155, 15, 1, 0, 0, 0, 156, 158,
+		3, 22, 11, 0, 157, 159, 5, 11, 0, 0, 158, 157, 1, 0, 0, 0, 158, 159, 1,
+		0, 0, 0, 159, 160, 1, 0, 0, 0, 160, 161, 5, 14, 0, 0, 161, 162, 3, 22,
+		11, 0, 162, 163, 5, 9, 0, 0, 163, 164, 3, 22, 11, 0, 164, 17, 1, 0, 0,
+		0, 165, 167, 3, 22, 11, 0, 166, 168, 5, 11, 0, 0, 167, 166, 1, 0, 0, 0,
+		167, 168, 1, 0, 0, 0, 168, 169, 1, 0, 0, 0, 169, 170, 5, 17, 0, 0, 170,
+		171, 5, 52, 0, 0, 171, 176, 3, 22, 11, 0, 172, 173, 5, 58, 0, 0, 173, 175,
+		3, 22, 11, 0, 174, 172, 1, 0, 0, 0, 175, 178, 1, 0, 0, 0, 176, 174, 1,
+		0, 0, 0, 176, 177, 1, 0, 0, 0, 177, 179, 1, 0, 0, 0, 178, 176, 1, 0, 0,
+		0, 179, 180, 5, 53, 0, 0, 180, 19, 1, 0, 0, 0, 181, 182, 3, 22, 11, 0,
+		182, 184, 5, 15, 0, 0, 183, 185, 5, 11, 0, 0, 184, 183, 1, 0, 0, 0, 184,
+		185, 1, 0, 0, 0, 185, 186, 1, 0, 0, 0, 186, 187, 5, 16, 0, 0, 187, 21,
+		1, 0, 0, 0, 188, 189, 6, 11, -1, 0, 189, 195, 3, 24, 12, 0, 190, 191, 5,
+		52, 0, 0, 191, 192, 3, 22, 11, 0, 192, 193, 5, 53, 0, 0, 193, 195, 1, 0,
+		0, 0, 194, 188, 1, 0, 0, 0, 194, 190, 1, 0, 0, 0, 195, 201, 1, 0, 0, 0,
+		196, 197, 10, 1, 0, 0, 197, 198, 5, 19, 0, 0, 198, 200, 3, 22, 11, 2, 199,
+		196, 1, 0, 0, 0, 200, 203, 1, 0, 0, 0, 201, 199, 1, 0, 0, 0, 201, 202,
+		1, 0, 0, 0, 202, 23, 1, 0, 0, 0, 203, 201, 1, 0, 0, 0, 204, 211, 3, 26,
+		13, 0, 205, 211, 3, 30, 15, 0, 206, 211, 3, 32, 16, 0, 207, 211, 3, 34,
+		17, 0, 208, 211, 3, 36, 18, 0, 209, 211, 3, 28, 14, 0, 210, 204, 1, 0,
+		0, 0, 210, 205, 1, 0, 0, 0, 210, 206, 1, 0, 0, 0, 210, 207, 1, 0, 0, 0,
+		210, 208, 1, 0, 0, 0, 210, 209, 1, 0, 0, 0, 211, 25, 1, 0, 0, 0, 212, 213,
+		7, 2, 0, 0, 213, 27, 1, 0, 0, 0, 214, 215, 5, 40, 0, 0, 215, 224, 5, 52,
+		0, 0, 216, 221, 3, 22, 11, 0, 217, 218, 5, 58, 0, 0, 218, 220, 3, 22, 11,
+		0, 219, 217, 1, 0, 0, 0, 220, 223, 1, 0, 0, 0, 221, 219, 1, 0, 0, 0, 221,
+		222, 1, 0, 0, 0, 222, 225, 1, 0, 0, 0, 223, 221, 1, 0, 0, 0, 224, 216,
+		1, 0, 0, 0, 224, 225, 1, 0, 0, 0, 225, 226, 1, 0, 0, 0, 226, 227, 5, 53,
+		0, 0, 227, 29, 1, 0, 0, 0, 228, 229, 5, 92, 0, 0, 229, 31, 1, 0, 0, 0,
+		230, 231, 5, 36, 0, 0, 231, 33, 1, 0, 0, 0, 232, 233, 5, 8, 0, 0, 233,
+		35, 1, 0, 0, 0, 234, 235, 5, 79, 0, 0, 235, 37, 1, 0, 0, 0, 236, 237, 5,
+		20, 0, 0, 237, 238, 5, 52, 0, 0, 238, 239, 3, 42, 21, 0, 239, 240, 5, 58,
+		0, 0, 240, 241, 3, 42, 21, 0, 241, 242, 5, 53, 0, 0, 242, 39, 1, 0, 0,
+		0, 243, 244, 5, 21, 0, 0, 244, 245, 5, 52, 0, 0, 245, 246, 3, 42, 21, 0,
+		246, 247, 5, 58, 0, 0, 247, 248, 3, 42, 21, 0, 248, 249, 5, 58, 0, 0, 249,
+		250, 5, 36, 0, 0, 250, 251, 5, 53, 0, 0, 251, 41, 1, 0, 0, 0, 252, 260,
+		3, 26, 13, 0, 253, 255, 5, 37, 0, 0, 254, 253, 1, 0, 0, 0, 254, 255, 1,
+		0, 0, 0, 255, 256, 1, 0, 0, 0, 256, 260, 3, 44, 22, 0, 257, 260, 7, 3,
+		0, 0, 258, 260, 5, 39, 0, 0, 259, 252, 1, 0, 0, 0, 259, 254, 1, 0, 0, 0,
+		259, 257, 1, 0, 0, 0, 259, 258, 1, 0, 0, 0, 260, 43, 1, 0, 0, 0, 261, 275,
+		3, 46, 23, 0, 262, 275, 3, 50, 25, 0, 263, 275, 3, 52, 26, 0, 264, 275,
+		3, 56, 28, 0, 265, 275, 3, 58, 29, 0, 266, 275, 3, 60, 30, 0, 267, 275,
+		3, 62, 31, 0, 268, 275, 3, 64, 32, 0, 269, 275, 3, 66, 33, 0, 270, 275,
+		3, 70, 35, 0, 271, 275, 3, 74, 37, 0, 272, 275, 3, 76, 38, 0, 273, 275,
+		3, 80, 40, 0, 274, 261, 1, 0, 0, 0, 274, 262, 1, 0, 0, 0, 274, 263, 1,
+		0, 0, 0, 274, 264, 1, 0, 0, 0, 274, 265, 1, 0, 0, 0, 274, 266, 1, 0, 0,
+		0, 274, 267, 1, 0, 0, 0, 274, 268, 1, 0, 0, 0, 274, 269, 1, 0, 0, 0, 274,
+		270, 1, 0, 0, 0, 274, 271, 1, 0, 0, 0, 274, 272, 1, 0, 0, 0, 274, 273,
+		1, 0, 0, 0, 275, 45, 1, 0, 0, 0, 276, 278, 5, 22, 0, 0, 277, 279, 3, 86,
+		43, 0, 278, 277, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 282, 1, 0, 0, 0,
+		280, 283, 3, 48, 24, 0, 281, 283, 5, 35, 0, 0, 282, 280, 1, 0, 0, 0, 282,
+		281, 1, 0, 0, 0, 283, 47, 1, 0, 0, 0, 284, 285, 5, 52, 0, 0, 285, 286,
+		3, 84, 42, 0, 286, 287, 5, 53, 0, 0, 287, 49, 1, 0, 0, 0, 288, 290, 5,
+		23, 0, 0, 289, 291, 3, 86, 43, 0, 290, 289, 1, 0, 0, 0, 290, 291, 1, 0,
+		0, 0, 291, 294, 1, 0, 0, 0, 292, 295, 3, 82, 41, 0, 293, 295, 5, 35, 0,
+		0, 294, 292, 1, 0, 0, 0, 294, 293, 1, 0, 0, 0, 295, 51, 1, 0, 0, 0, 296,
+		298, 5, 24, 0, 0, 297, 299, 3, 86, 43, 0, 298, 297, 1, 0, 0, 0, 298, 299,
+		1, 0, 0, 0, 299, 302, 1, 0, 0, 0, 300, 303, 3, 54, 27, 0, 301, 303, 5,
+		35, 0, 0, 302, 300, 1, 0, 0, 0, 302, 301, 1, 0, 0, 0, 303, 53, 1, 0, 0,
+		0, 304, 305, 5, 52, 0, 0, 305, 310, 3, 82, 41, 0, 306, 307, 5, 58, 0, 0,
+		307, 309, 3, 82, 41, 0, 308, 306, 1, 0, 0, 0, 309, 312, 1, 0, 0, 0, 310,
+		308, 1, 0, 0, 0, 310, 311, 1, 0, 0, 0, 311, 313, 1, 0, 0, 0, 312, 310,
+		1, 0, 0, 0, 313, 314, 5, 53, 0, 0, 314, 55, 1, 0, 0, 0, 315, 317, 5, 25,
+		0, 0, 316, 318, 3, 86, 43, 0, 317, 316, 1, 0, 0, 0, 317, 318, 1, 0, 0,
+		0, 318, 331, 1, 0, 0, 0, 319, 320, 5, 52, 0, 0, 320, 325, 3, 48, 24, 0,
+		321, 322, 5, 58, 0, 0, 322, 324, 3, 48, 24, 0, 323, 321, 1, 0, 0, 0, 324,
+		327, 1, 0, 0, 0, 325, 323, 1, 0, 0, 0, 325, 326, 1, 0, 0, 0, 326, 328,
+		1, 0, 0, 0, 327, 325, 1, 0, 0, 0, 328, 329, 5, 53, 0, 0, 329, 332, 1, 0,
+		0, 0, 330, 332, 5, 35, 0, 0, 331, 319, 1, 0, 0, 0, 331, 330, 1, 0, 0, 0,
+		332, 57, 1, 0, 0, 0, 333, 335, 5, 26, 0, 0, 334, 336, 3, 86, 43, 0, 335,
+		334, 1, 0, 0, 0, 335, 336, 1, 0, 0, 0, 336, 349, 1, 0, 0, 0, 337, 338,
+		5, 52, 0, 0, 338, 343, 3, 82, 41, 0, 339, 340, 5, 58, 0, 0, 340, 342, 3,
+		82, 41, 0, 341, 339, 1, 0, 0, 0, 342, 345, 1, 0, 0, 0, 343, 341, 1, 0,
+		0, 0, 343, 344, 1, 0, 0, 0, 344, 346, 1, 0, 0, 0, 345, 343, 1, 0, 0, 0,
+		346, 347, 5, 53, 0, 0, 347, 350, 1, 0, 0, 0, 348, 350, 5, 35, 0, 0, 349,
+		337, 1, 0, 0, 0, 349, 348, 1, 0, 0, 0, 350, 59, 1, 0, 0, 0, 351, 353, 5,
+		27, 0, 0, 352, 354, 3, 86, 43, 0, 353, 352, 1, 0, 0, 0, 353, 354, 1, 0,
+		0, 0, 354, 367, 1, 0, 0, 0, 355, 356, 5, 52, 0, 0, 356, 361, 3, 54, 27,
+		0, 357, 358, 5, 58, 0, 0, 358, 360, 3, 54, 27, 0, 359, 357, 1, 0, 0, 0,
+		360, 363, 1, 0, 0, 0, 361, 359, 1, 0, 0, 0, 361, 362, 1, 0, 0, 0, 362,
+		364, 1, 0, 0, 0, 363, 361, 1, 0, 0, 0, 364, 365, 5, 53, 0, 0, 365, 368,
+		1, 0, 0, 0, 366, 368, 5, 35, 0, 0, 367, 355, 1, 0, 0, 0, 367, 366, 1, 0,
+		0, 0, 368, 61, 1, 0, 0, 0, 369, 371, 5, 28, 0, 0, 370, 372, 3, 86, 43,
+		0, 371, 370, 1, 0, 0, 0, 371, 372, 1, 0, 0, 0, 372, 385, 1, 0, 0, 0, 373,
+		374, 5, 52, 0, 0, 374, 379, 3, 44, 22, 0, 375, 376, 5, 58, 0, 0, 376, 378,
+		3, 44, 22, 0, 377, 375, 1, 0, 0, 0, 378, 381, 1, 0, 0, 0, 379, 377, 1,
+		0, 0, 0, 379, 380, 1, 0, 0, 0, 380, 382, 1, 0, 0, 0, 381, 379, 1, 0, 0,
+		0, 382, 383, 5, 53, 0, 0, 383, 386, 1, 0, 0, 0, 384, 386, 5, 35, 0, 0,
+		385, 373, 1, 0, 0, 0, 385, 384, 1, 0, 0, 0, 386, 63, 1, 0, 0, 0, 387, 389,
+		5, 30, 0, 0, 388, 390, 3, 86, 43, 0, 389, 388, 1, 0, 0, 0, 389, 390, 1,
+		0, 0, 0, 390, 393, 1, 0, 0, 0, 391, 394, 3, 82, 41, 0, 392, 394, 5, 35,
+		0, 0, 393, 391, 1, 0, 0, 0, 393, 392, 1, 0, 0, 0, 394, 65, 1, 0, 0, 0,
+		395, 397, 5, 31, 0, 0, 396, 398, 3, 86, 43, 0, 397, 396, 1, 0, 0, 0, 397,
+		398, 1, 0, 0, 0, 398, 411, 1, 0, 0, 0, 399, 400, 5, 52, 0, 0, 400, 405,
+		3, 68, 34, 0, 401, 402, 5, 58, 0, 0, 402, 404, 3, 68, 34, 0, 403, 401,
+		1, 0, 0, 0, 404, 407, 1, 0, 0, 0, 405, 403, 1, 0, 0, 0, 405, 406, 1, 0,
+		0, 0, 406, 408, 1, 0, 0, 0, 407, 405, 1, 0, 0, 0, 408, 409, 5, 53, 0, 0,
+		409, 412, 1, 0, 0, 0, 410, 412, 5, 35, 0, 0, 411, 399, 1, 0, 0, 0, 411,
+		410, 1, 0, 0, 0, 412, 67, 1, 0, 0, 0, 413, 416, 3, 82, 41, 0, 414, 416,
+		3, 64, 32, 0, 415, 413, 1, 0, 0, 0, 415, 414, 1, 0, 0, 0, 416, 69, 1, 0,
+		0, 0, 417, 419, 5, 32, 0, 0, 418, 420, 3, 86, 43, 0, 419, 418, 1, 0, 0,
+		0, 419, 420, 1, 0, 0, 0, 420, 433, 1, 0, 0, 0, 421, 422, 5, 52, 0, 0, 422,
+		427, 3, 72, 36, 0, 423, 424, 5, 58, 0, 0, 424, 426, 3, 72, 36, 0, 425,
+		423, 1, 0, 0, 0, 426, 429, 1, 0, 0, 0, 427, 425, 1, 0, 0, 0, 427, 428,
+		1, 0, 0, 0, 428, 430, 1, 0, 0, 0, 429, 427, 1, 0, 0, 0, 430, 431, 5, 53,
+		0, 0, 431, 434, 1, 0, 0, 0, 432, 434, 5, 35, 0, 0, 433, 421, 1, 0, 0, 0,
+		433, 432, 1, 0, 0, 0, 434, 71, 1, 0, 0, 0, 435, 439, 3, 82, 41, 0, 436,
+		439, 3, 64, 32, 0, 437, 439, 3, 66, 33, 0, 438, 435, 1, 0, 0, 0, 438, 436,
+		1, 0, 0, 0, 438, 437, 1, 0, 0, 0, 439, 73, 1, 0, 0, 0, 440, 442, 5, 33,
+		0, 0, 441, 443, 3, 86, 43, 0, 442, 441, 1, 0, 0, 0, 442, 443, 1, 0, 0,
+		0, 443, 456, 1, 0, 0, 0, 444, 445, 5, 52, 0, 0, 445, 450, 3, 72, 36, 0,
+		446, 447, 5, 58, 0, 0, 447, 449, 3, 72, 36, 0, 448, 446, 1, 0, 0, 0, 449,
+		452, 1, 0, 0, 0, 450, 448, 1, 0, 0, 0, 450, 451, 1, 0, 0, 0, 451, 453,
+		1, 0, 0, 0, 452, 450, 1, 0, 0, 0, 453, 454, 5, 53, 0, 0, 454, 457, 1, 0,
+		0, 0, 455, 457, 5, 35, 0, 0, 456, 444, 1, 0, 0, 0, 456, 455, 1, 0, 0, 0,
+		457, 75, 1, 0, 0, 0, 458, 460, 5, 34, 0, 0, 459, 461, 3, 86, 43, 0, 460,
+		459, 1, 0, 0, 0, 460, 461, 1, 0, 0, 0, 461, 474, 1, 0, 0, 0, 462, 463,
+		5, 52, 0, 0, 463, 468, 3, 78, 39, 0, 464, 465, 5, 58, 0, 0, 465, 467, 3,
+		78, 39, 0, 466, 464, 1, 0, 0, 0, 467, 470, 1, 0, 0, 0, 468, 466, 1, 0,
+		0, 0, 468, 469, 1, 0, 0, 0, 469, 471, 1, 0, 0, 0, 470, 468, 1, 0, 0, 0,
+		471, 472, 5, 53, 0, 0, 472, 475, 1, 0, 0, 0, 473, 475, 5, 35, 0, 0, 474,
+		462, 1, 0, 0, 0, 474, 473, 1, 0, 0, 0, 475, 77, 1, 0, 0, 0, 476, 479, 3,
+		54, 27, 0, 477, 479, 3, 70, 35, 0, 478, 476, 1, 0, 0, 0, 478, 477, 1, 0,
+		0, 0, 479, 79, 1, 0, 0, 0, 480, 481, 5, 29, 0, 0, 481, 482, 5, 52, 0, 0,
+		482, 483, 5, 36, 0, 0, 483, 484, 5, 58, 0, 0, 484, 485, 5, 36, 0, 0, 485,
+		486, 5, 58, 0, 0, 486, 487, 5, 36, 0, 0, 487, 488, 5, 58, 0, 0, 488, 493,
+		5, 36, 0, 0, 489, 490, 5, 58, 0, 0, 490, 491, 5, 36, 0, 0, 491, 492, 5,
+		58, 0, 0, 492, 494, 5, 36, 0, 0, 493, 489, 1, 0, 0, 0, 493, 494, 1, 0,
+		0, 0, 494, 495, 1, 0, 0, 0, 495, 496, 5, 53, 0, 0, 496, 81, 1, 0, 0, 0,
+		497, 498, 5, 52, 0, 0, 498, 503, 3, 84, 42, 0, 499, 500, 5, 58, 0, 0, 500,
+		502, 3, 84, 42, 0, 501, 499, 1, 0, 0, 0, 502, 505, 1, 0, 0, 0, 503, 501,
+		1, 0, 0, 0, 503, 504, 1, 0, 0, 0, 504, 506, 1, 0, 0, 0, 505, 503, 1, 0,
+		0, 0, 506, 507, 5, 53, 0, 0, 507, 83, 1, 0, 0, 0, 508, 509, 5, 36, 0, 0,
+		509, 514, 5, 36, 0, 0, 510, 512, 5, 36, 0, 0, 511, 513, 5, 36, 0, 0, 512,
+		511, 1, 0, 0, 0, 512, 513, 1, 0, 0, 0, 513, 515, 1, 0, 0, 0, 514, 510,
+		1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515, 85, 1, 0, 0, 0, 516, 517, 5, 40,
+		0, 0, 517, 87, 1, 0, 0, 0, 63, 97, 100, 105, 113, 115, 122, 126, 129, 136,
+		143, 151, 158, 167, 176, 184, 194, 201, 210, 221, 224, 254, 259, 274, 278,
+		282, 290, 294, 298, 302, 310, 317, 325, 331, 335, 343, 349, 353, 361, 367,
+		371, 379, 385, 389, 393, 397, 405, 411, 415, 419, 427, 433, 438, 442, 450,
+		456, 460, 468, 474, 478, 493, 503, 512, 514,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -258,72 +364,82 @@ const (
 	CQLParserIS                        = 15
 	CQLParserNULL                      = 16
 	CQLParserIN                        = 17
-	CQLParserArithmeticOperator        = 18
-	CQLParserSpatialOperator           = 19
-	CQLParserDistanceOperator          = 20
-	CQLParserPOINT                     = 21
-	CQLParserLINESTRING                = 22
-	CQLParserPOLYGON                   = 23
-	CQLParserMULTIPOINT                = 24
-	CQLParserMULTILINESTRING           = 25
-	CQLParserMULTIPOLYGON              = 26
-	CQLParserGEOMETRYCOLLECTION        = 27
-	CQLParserENVELOPE                  = 28
-	CQLParserNumericLiteral            = 29
-	CQLParserIdentifier                = 30
-	CQLParserIdentifierStart           = 31
-	CQLParserIdentifierPart            = 32
-	CQLParserALPHA                     = 33
-	CQLParserDIGIT                     = 34
-	CQLParserOCTOTHORP                 = 35
-	CQLParserDOLLAR                    = 36
-	CQLParserUNDERSCORE                = 37
-	CQLParserDOUBLEQUOTE               = 38
-	CQLParserPERCENT                   = 39
-	CQLParserAMPERSAND                 = 40
-	CQLParserQUOTE                     = 41
-	CQLParserLEFTPAREN                 = 42
-	CQLParserRIGHTPAREN                = 43
-	CQLParserLEFTSQUAREBRACKET         = 44
-	CQLParserRIGHTSQUAREBRACKET        = 45
-	CQLParserASTERISK                  = 46
-	CQLParserPLUS                      = 47
-	CQLParserCOMMA                     = 48
-	CQLParserMINUS                     = 49
-	CQLParserPERIOD                    = 50
-	CQLParserSOLIDUS                   = 51
-	CQLParserCARET                     = 52
-	CQLParserCONCAT                    = 53
-	CQLParserCOLON                     = 54
-	CQLParserSEMICOLON                 = 55
-	CQLParserQUESTIONMARK              = 56
-	CQLParserVERTICALBAR               = 57
-	CQLParserBIT                       = 58
-	CQLParserHEXIT                     = 59
-	CQLParserUnsignedNumericLiteral    = 60
-	CQLParserSignedNumericLiteral      = 61
-	CQLParserExactNumericLiteral       = 62
-	CQLParserApproximateNumericLiteral = 63
-	CQLParserMantissa                  = 64
-	CQLParserExponent                  = 65
-	CQLParserSignedInteger             = 66
-	CQLParserUnsignedInteger           = 67
-	CQLParserSign                      = 68
-	CQLParserTemporalLiteral           = 69
-	CQLParserInstant                   = 70
-	CQLParserFullDate                  = 71
-	CQLParserDateYear                  = 72
-	CQLParserDateMonth                 = 73
-	CQLParserDateDay                   = 74
-	CQLParserUtcTime                   = 75
-	CQLParserTimeZoneOffset            = 76
-	CQLParserTimeHour                  = 77
-	CQLParserTimeMinute                = 78
-	CQLParserTimeSecond                = 79
-	CQLParserNOW                       = 80
-	CQLParserWS                        = 81
-	CQLParserCharacterStringLiteral    = 82
-	CQLParserQuotedQuote               = 83
+	CQLParserUNKNOWN                   = 18
+	CQLParserArithmeticOperator        = 19
+	CQLParserSpatialOperator           = 20
+	CQLParserDistanceOperator          = 21
+	CQLParserPOINT                     = 22
+	CQLParserLINESTRING                = 23
+	CQLParserPOLYGON                   = 24
+	CQLParserMULTIPOINT                = 25
+	CQLParserMULTILINESTRING           = 26
+	CQLParserMULTIPOLYGON              = 27
+	CQLParserGEOMETRYCOLLECTION        = 28
+	CQLParserENVELOPE                  = 29
+	CQLParserCIRCULARSTRING            = 30
+	CQLParserCOMPOUNDCURVE             = 31
+	CQLParserCURVEPOLYGON              = 32
+	CQLParserMULTICURVE                = 33
+	CQLParserMULTISURFACE              = 34
+	CQLParserEMPTY                     = 35
+	CQLParserNumericLiteral            = 36
+	CQLParserEwktSridPrefix            = 37
+	CQLParserWkbHexLiteral             = 38
+	CQLParserGeoJsonLiteral            = 39
+	CQLParserIdentifier                = 40
+	CQLParserIdentifierStart           = 41
+	CQLParserIdentifierPart            = 42
+	CQLParserALPHA                     = 43
+	CQLParserDIGIT                     = 44
+	CQLParserOCTOTHORP                 = 45
+	CQLParserDOLLAR                    = 46
+	CQLParserUNDERSCORE                = 47
+	CQLParserDOUBLEQUOTE               = 48
+	CQLParserPERCENT                   = 49
+	CQLParserAMPERSAND                 = 50
+	CQLParserQUOTE                     = 51
+	CQLParserLEFTPAREN                 = 52
+	CQLParserRIGHTPAREN                = 53
+	CQLParserLEFTSQUAREBRACKET         = 54
+	CQLParserRIGHTSQUAREBRACKET        = 55
+	CQLParserASTERISK                  = 56
+	CQLParserPLUS                      = 57
+	CQLParserCOMMA                     = 58
+	CQLParserMINUS                     = 59
+	CQLParserPERIOD                    = 60
+	CQLParserSOLIDUS                   = 61
+	CQLParserCARET                     = 62
+	CQLParserCONCAT                    = 63
+	CQLParserCOLON                     = 64
+	CQLParserSEMICOLON                 = 65
+	CQLParserQUESTIONMARK              = 66
+	CQLParserVERTICALBAR               = 67
+	CQLParserBIT                       = 68
+	CQLParserHEXIT                     = 69
+	CQLParserUnsignedNumericLiteral    = 70
+	CQLParserSignedNumericLiteral      = 71
+	CQLParserExactNumericLiteral       = 72
+	CQLParserApproximateNumericLiteral = 73
+	CQLParserMantissa                  = 74
+	CQLParserExponent                  = 75
+	CQLParserSignedInteger             = 76
+	CQLParserUnsignedInteger           = 77
+	CQLParserSign                      = 78
+	CQLParserTemporalLiteral           = 79
+	CQLParserInstant                   = 80
+	CQLParserFullDate                  = 81
+	CQLParserDateYear                  = 82
+	CQLParserDateMonth                 = 83
+	CQLParserDateDay                   = 84
+	CQLParserUtcTime                   = 85
+	CQLParserTimeZoneOffset            = 86
+	CQLParserTimeHour                  = 87
+	CQLParserTimeMinute                = 88
+	CQLParserTimeSecond                = 89
+	CQLParserNOW                       = 90
+	CQLParserWS                        = 91
+	CQLParserCharacterStringLiteral    = 92
+	CQLParserQuotedQuote               = 93
 )
 
 // CQLParser rules.
@@ -331,36 +447,47 @@ const (
 	CQLParserRULE_cqlFilter                 = 0
 	CQLParserRULE_booleanExpression         = 1
 	CQLParserRULE_booleanTerm               = 2
-	CQLParserRULE_predicate                 = 3
-	CQLParserRULE_comparisonPredicate       = 4
-	CQLParserRULE_binaryComparisonPredicate = 5
-	CQLParserRULE_isLikePredicate           = 6
-	CQLParserRULE_isBetweenPredicate        = 7
-	CQLParserRULE_isInListPredicate         = 8
-	CQLParserRULE_isNullPredicate           = 9
-	CQLParserRULE_scalarExpression          = 10
-	CQLParserRULE_scalarValue               = 11
-	CQLParserRULE_propertyName              = 12
-	CQLParserRULE_characterLiteral          = 13
-	CQLParserRULE_numericLiteral            = 14
-	CQLParserRULE_booleanLiteral            = 15
-	CQLParserRULE_temporalLiteral           = 16
-	CQLParserRULE_spatialPredicate          = 17
-	CQLParserRULE_distancePredicate         = 18
-	CQLParserRULE_geomExpression            = 19
-	CQLParserRULE_geomLiteral               = 20
-	CQLParserRULE_point                     = 21
-	CQLParserRULE_pointList                 = 22
-	CQLParserRULE_linestring                = 23
-	CQLParserRULE_polygon                   = 24
-	CQLParserRULE_polygonDef                = 25
-	CQLParserRULE_multiPoint                = 26
-	CQLParserRULE_multiLinestring           = 27
-	CQLParserRULE_multiPolygon              = 28
-	CQLParserRULE_geometryCollection        = 29
-	CQLParserRULE_envelope                  = 30
-	CQLParserRULE_coordList                 = 31
-	CQLParserRULE_coordinate                = 32
+	CQLParserRULE_truthValue                = 3
+	CQLParserRULE_predicate                 = 4
+	CQLParserRULE_comparisonPredicate       = 5
+	CQLParserRULE_binaryComparisonPredicate = 6
+	CQLParserRULE_isLikePredicate           = 7
+	CQLParserRULE_isBetweenPredicate        = 8
+	CQLParserRULE_isInListPredicate         = 9
+	CQLParserRULE_isNullPredicate           = 10
+	CQLParserRULE_scalarExpression          = 11
+	CQLParserRULE_scalarValue               = 12
+	CQLParserRULE_propertyName              = 13
+	CQLParserRULE_function                  = 14
+	CQLParserRULE_characterLiteral          = 15
+	CQLParserRULE_numericLiteral            = 16
+	CQLParserRULE_booleanLiteral            = 17
+	CQLParserRULE_temporalLiteral           = 18
+	CQLParserRULE_spatialPredicate          = 19
+	CQLParserRULE_distancePredicate         = 20
+	CQLParserRULE_geomExpression            = 21
+	CQLParserRULE_geomLiteral               = 22
+	CQLParserRULE_point                     = 23
+	CQLParserRULE_pointList                 = 24
+	CQLParserRULE_linestring                = 25
+	CQLParserRULE_polygon                   = 26
+	CQLParserRULE_polygonDef                = 27
+	CQLParserRULE_multiPoint                = 28
+	CQLParserRULE_multiLinestring           = 29
+	CQLParserRULE_multiPolygon              = 30
+	CQLParserRULE_geometryCollection        = 31
+	CQLParserRULE_circularString            = 32
+	CQLParserRULE_compoundCurve             = 33
+	CQLParserRULE_curveMember               = 34
+	CQLParserRULE_curvePolygon              = 35
+	CQLParserRULE_curveRing                 = 36
+	CQLParserRULE_multiCurve                = 37
+	CQLParserRULE_multiSurface              = 38
+	CQLParserRULE_surfaceMember             = 39
+	CQLParserRULE_envelope                  = 40
+	CQLParserRULE_coordList                 = 41
+	CQLParserRULE_coordinate                = 42
+	CQLParserRULE_dimension                 = 43
 )
 
 // ICqlFilterContext is an interface to support dynamic dispatch.
@@ -454,11 +581,11 @@ func (p *CQLParser) CqlFilter() (localctx ICqlFilterContext) {
 	p.EnterRule(localctx, 0, CQLParserRULE_cqlFilter)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(66)
+		p.SetState(88)
 		p.booleanExpression(0)
 	}
 	{
-		p.SetState(67)
+		p.SetState(89)
 		p.Match(CQLParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -574,6 +701,30 @@ func (s *BoolExprParenContext) RIGHTPAREN() antlr.TerminalNode {
 	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
+func (s *BoolExprParenContext) IS() antlr.TerminalNode {
+	return s.GetToken(CQLParserIS, 0)
+}
+
+func (s *BoolExprParenContext) TruthValue() ITruthValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITruthValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITruthValueContext)
+}
+
+func (s *BoolExprParenContext) NOT() antlr.TerminalNode {
+	return s.GetToken(CQLParserNOT, 0)
+}
+
 func (s *BoolExprParenContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLParserListener); ok {
 		listenerT.EnterBoolExprParen(s)
@@ -865,23 +1016,25 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
 	_startState := 2
 	p.EnterRecursionRule(localctx, 2, CQLParserRULE_booleanExpression, _p)
+	var _la int
+
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(77)
+	p.SetState(105)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 0, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 2, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewBoolExprParenContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(70)
+			p.SetState(92)
 			p.Match(CQLParserLEFTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -889,24 +1042,62 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 			}
 		}
 		{
-			p.SetState(71)
+			p.SetState(93)
 			p.booleanExpression(0)
 		}
 		{
-			p.SetState(72)
+			p.SetState(94)
 			p.Match(CQLParserRIGHTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
+		p.SetState(100)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(95)
+				p.Match(CQLParserIS)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			p.SetState(97)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == CQLParserNOT {
+				{
+					p.SetState(96)
+					p.Match(CQLParserNOT)
+					if p.HasError() {
+						// Recognition error - abort rule
+						goto errorExit
+					}
+				}
+
+			}
+			{
+				p.SetState(99)
+				p.TruthValue()
+			}
+
+		} else if p.HasError() { // JIM
+			goto errorExit
+		}
 
 	case 2:
 		localctx = NewBoolExprNotContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(74)
+			p.SetState(102)
 			p.Match(CQLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -914,7 +1105,7 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 			}
 		}
 		{
-			p.SetState(75)
+			p.SetState(103)
 			p.booleanExpression(2)
 		}
 
@@ -923,7 +1114,7 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(76)
+			p.SetState(104)
 			p.BooleanTerm()
 		}
 
@@ -931,12 +1122,12 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(87)
+	p.SetState(115)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 2, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 4, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -946,26 +1137,26 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(85)
+			p.SetState(113)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 1, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewBoolExprAndContext(p, NewBooleanExpressionContext(p, _parentctx, _parentState))
 				localctx.(*BoolExprAndContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, CQLParserRULE_booleanExpression)
-				p.SetState(79)
+				p.SetState(107)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(80)
+					p.SetState(108)
 					p.Match(CQLParserAND)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -973,7 +1164,7 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 					}
 				}
 				{
-					p.SetState(81)
+					p.SetState(109)
 
 					var _x = p.booleanExpression(5)
 
@@ -985,14 +1176,14 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 				localctx.(*BoolExprOrContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, CQLParserRULE_booleanExpression)
-				p.SetState(82)
+				p.SetState(110)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(83)
+					p.SetState(111)
 					p.Match(CQLParserOR)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -1000,7 +1191,7 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 					}
 				}
 				{
-					p.SetState(84)
+					p.SetState(112)
 
 					var _x = p.booleanExpression(4)
 
@@ -1012,12 +1203,12 @@ func (p *CQLParser) booleanExpression(_p int) (localctx IBooleanExpressionContex
 			}
 
 		}
-		p.SetState(89)
+		p.SetState(117)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 2, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 4, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -1046,6 +1237,11 @@ type IBooleanTermContext interface {
 	// Getter signatures
 	Predicate() IPredicateContext
 	BooleanLiteral() IBooleanLiteralContext
+	PropertyName() IPropertyNameContext
+	Function() IFunctionContext
+	IS() antlr.TerminalNode
+	TruthValue() ITruthValueContext
+	NOT() antlr.TerminalNode
 
 	// IsBooleanTermContext differentiates from other interfaces.
 	IsBooleanTermContext()
@@ -1114,6 +1310,62 @@ func (s *BooleanTermContext) BooleanLiteral() IBooleanLiteralContext {
 	return t.(IBooleanLiteralContext)
 }
 
+func (s *BooleanTermContext) PropertyName() IPropertyNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPropertyNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPropertyNameContext)
+}
+
+func (s *BooleanTermContext) Function() IFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionContext)
+}
+
+func (s *BooleanTermContext) IS() antlr.TerminalNode {
+	return s.GetToken(CQLParserIS, 0)
+}
+
+func (s *BooleanTermContext) TruthValue() ITruthValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITruthValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITruthValueContext)
+}
+
+func (s *BooleanTermContext) NOT() antlr.TerminalNode {
+	return s.GetToken(CQLParserNOT, 0)
+}
+
 func (s *BooleanTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1137,30 +1389,186 @@ func (s *BooleanTermContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *CQLParser) BooleanTerm() (localctx IBooleanTermContext) {
 	localctx = NewBooleanTermContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 4, CQLParserRULE_booleanTerm)
-	p.SetState(92)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(122)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext()) {
 	case 1:
-		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(90)
+			p.SetState(118)
 			p.Predicate()
 		}
 
 	case 2:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(91)
+			p.SetState(119)
 			p.BooleanLiteral()
 		}
 
+	case 3:
+		{
+			p.SetState(120)
+			p.PropertyName()
+		}
+
+	case 4:
+		{
+			p.SetState(121)
+			p.Function()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
+	p.SetState(129)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(124)
+			p.Match(CQLParserIS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(126)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CQLParserNOT {
+			{
+				p.SetState(125)
+				p.Match(CQLParserNOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+		{
+			p.SetState(128)
+			p.TruthValue()
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITruthValueContext is an interface to support dynamic dispatch.
+type ITruthValueContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	BooleanLiteral() antlr.TerminalNode
+	UNKNOWN() antlr.TerminalNode
+
+	// IsTruthValueContext differentiates from other interfaces.
+	IsTruthValueContext()
+}
+
+type TruthValueContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyTruthValueContext() *TruthValueContext {
+	var p = new(TruthValueContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_truthValue
+	return p
+}
+
+func InitEmptyTruthValueContext(p *TruthValueContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_truthValue
+}
+
+func (*TruthValueContext) IsTruthValueContext() {}
+
+func NewTruthValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TruthValueContext {
+	var p = new(TruthValueContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_truthValue
+
+	return p
+}
+
+func (s *TruthValueContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TruthValueContext) BooleanLiteral() antlr.TerminalNode {
+	return s.GetToken(CQLParserBooleanLiteral, 0)
+}
+
+func (s *TruthValueContext) UNKNOWN() antlr.TerminalNode {
+	return s.GetToken(CQLParserUNKNOWN, 0)
+}
+
+func (s *TruthValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TruthValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TruthValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterTruthValue(s)
+	}
+}
+
+func (s *TruthValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitTruthValue(s)
+	}
+}
+
+func (p *CQLParser) TruthValue() (localctx ITruthValueContext) {
+	localctx = NewTruthValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, CQLParserRULE_truthValue)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(131)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == CQLParserBooleanLiteral || _la == CQLParserUNKNOWN) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -1292,32 +1700,32 @@ func (s *PredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Predicate() (localctx IPredicateContext) {
 	localctx = NewPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, CQLParserRULE_predicate)
-	p.SetState(97)
+	p.EnterRule(localctx, 8, CQLParserRULE_predicate)
+	p.SetState(136)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case CQLParserBooleanLiteral, CQLParserNumericLiteral, CQLParserIdentifier, CQLParserLEFTPAREN, CQLParserTemporalLiteral, CQLParserCharacterStringLiteral:
+	case CQLParserBooleanLiteral, CQLParserUNKNOWN, CQLParserENVELOPE, CQLParserCIRCULARSTRING, CQLParserCOMPOUNDCURVE, CQLParserCURVEPOLYGON, CQLParserMULTICURVE, CQLParserMULTISURFACE, CQLParserEMPTY, CQLParserNumericLiteral, CQLParserIdentifier, CQLParserLEFTPAREN, CQLParserTemporalLiteral, CQLParserCharacterStringLiteral:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(94)
+			p.SetState(133)
 			p.ComparisonPredicate()
 		}
 
 	case CQLParserSpatialOperator:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(95)
+			p.SetState(134)
 			p.SpatialPredicate()
 		}
 
 	case CQLParserDistanceOperator:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(96)
+			p.SetState(135)
 			p.DistancePredicate()
 		}
 
@@ -1624,19 +2032,19 @@ func (s *PredicateIsNullContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) ComparisonPredicate() (localctx IComparisonPredicateContext) {
 	localctx = NewComparisonPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, CQLParserRULE_comparisonPredicate)
-	p.SetState(104)
+	p.EnterRule(localctx, 10, CQLParserRULE_comparisonPredicate)
+	p.SetState(143)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 5, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPredicateBinaryCompContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(99)
+			p.SetState(138)
 			p.BinaryComparisonPredicate()
 		}
 
@@ -1644,7 +2052,7 @@ func (p *CQLParser) ComparisonPredicate() (localctx IComparisonPredicateContext)
 		localctx = NewPredicateLikeContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(100)
+			p.SetState(139)
 			p.IsLikePredicate()
 		}
 
@@ -1652,7 +2060,7 @@ func (p *CQLParser) ComparisonPredicate() (localctx IComparisonPredicateContext)
 		localctx = NewPredicateBetweenContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(101)
+			p.SetState(140)
 			p.IsBetweenPredicate()
 		}
 
@@ -1660,7 +2068,7 @@ func (p *CQLParser) ComparisonPredicate() (localctx IComparisonPredicateContext)
 		localctx = NewPredicateInContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(102)
+			p.SetState(141)
 			p.IsInListPredicate()
 		}
 
@@ -1668,7 +2076,7 @@ func (p *CQLParser) ComparisonPredicate() (localctx IComparisonPredicateContext)
 		localctx = NewPredicateIsNullContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(103)
+			p.SetState(142)
 			p.IsNullPredicate()
 		}
 
@@ -1836,17 +2244,17 @@ func (s *BinaryComparisonPredicateContext) ExitRule(listener antlr.ParseTreeList
 
 func (p *CQLParser) BinaryComparisonPredicate() (localctx IBinaryComparisonPredicateContext) {
 	localctx = NewBinaryComparisonPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, CQLParserRULE_binaryComparisonPredicate)
+	p.EnterRule(localctx, 12, CQLParserRULE_binaryComparisonPredicate)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(106)
+		p.SetState(145)
 
 		var _x = p.scalarExpression(0)
 
 		localctx.(*BinaryComparisonPredicateContext).left = _x
 	}
 	{
-		p.SetState(107)
+		p.SetState(146)
 
 		var _m = p.Match(CQLParserComparisonOperator)
 
@@ -1857,7 +2265,7 @@ func (p *CQLParser) BinaryComparisonPredicate() (localctx IBinaryComparisonPredi
 		}
 	}
 	{
-		p.SetState(108)
+		p.SetState(147)
 
 		var _x = p.scalarExpression(0)
 
@@ -1885,8 +2293,8 @@ type IIsLikePredicateContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PropertyName() IPropertyNameContext
-	CharacterLiteral() ICharacterLiteralContext
+	AllScalarExpression() []IScalarExpressionContext
+	ScalarExpression(i int) IScalarExpressionContext
 	LIKE() antlr.TerminalNode
 	ILIKE() antlr.TerminalNode
 	NOT() antlr.TerminalNode
@@ -1926,28 +2334,37 @@ func NewIsLikePredicateContext(parser antlr.Parser, parent antlr.ParserRuleConte
 
 func (s *IsLikePredicateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IsLikePredicateContext) PropertyName() IPropertyNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPropertyNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *IsLikePredicateContext) AllScalarExpression() []IScalarExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IScalarExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IScalarExpressionContext); ok {
+			tst[i] = t.(IScalarExpressionContext)
+			i++
+		}
 	}
 
-	return t.(IPropertyNameContext)
+	return tst
 }
 
-func (s *IsLikePredicateContext) CharacterLiteral() ICharacterLiteralContext {
+func (s *IsLikePredicateContext) ScalarExpression(i int) IScalarExpressionContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICharacterLiteralContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IScalarExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -1955,7 +2372,7 @@ func (s *IsLikePredicateContext) CharacterLiteral() ICharacterLiteralContext {
 		return nil
 	}
 
-	return t.(ICharacterLiteralContext)
+	return t.(IScalarExpressionContext)
 }
 
 func (s *IsLikePredicateContext) LIKE() antlr.TerminalNode {
@@ -1992,15 +2409,15 @@ func (s *IsLikePredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) IsLikePredicate() (localctx IIsLikePredicateContext) {
 	localctx = NewIsLikePredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, CQLParserRULE_isLikePredicate)
+	p.EnterRule(localctx, 14, CQLParserRULE_isLikePredicate)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(110)
-		p.PropertyName()
+		p.SetState(149)
+		p.scalarExpression(0)
 	}
-	p.SetState(112)
+	p.SetState(151)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2009,7 +2426,7 @@ func (p *CQLParser) IsLikePredicate() (localctx IIsLikePredicateContext) {
 
 	if _la == CQLParserNOT {
 		{
-			p.SetState(111)
+			p.SetState(150)
 			p.Match(CQLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2019,7 +2436,7 @@ func (p *CQLParser) IsLikePredicate() (localctx IIsLikePredicateContext) {
 
 	}
 	{
-		p.SetState(114)
+		p.SetState(153)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == CQLParserLIKE || _la == CQLParserILIKE) {
@@ -2030,8 +2447,8 @@ func (p *CQLParser) IsLikePredicate() (localctx IIsLikePredicateContext) {
 		}
 	}
 	{
-		p.SetState(115)
-		p.CharacterLiteral()
+		p.SetState(154)
+		p.scalarExpression(0)
 	}
 
 errorExit:
@@ -2171,15 +2588,15 @@ func (s *IsBetweenPredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) IsBetweenPredicate() (localctx IIsBetweenPredicateContext) {
 	localctx = NewIsBetweenPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, CQLParserRULE_isBetweenPredicate)
+	p.EnterRule(localctx, 16, CQLParserRULE_isBetweenPredicate)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(117)
+		p.SetState(156)
 		p.scalarExpression(0)
 	}
-	p.SetState(119)
+	p.SetState(158)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2188,7 +2605,7 @@ func (p *CQLParser) IsBetweenPredicate() (localctx IIsBetweenPredicateContext) {
 
 	if _la == CQLParserNOT {
 		{
-			p.SetState(118)
+			p.SetState(157)
 			p.Match(CQLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2198,7 +2615,7 @@ func (p *CQLParser) IsBetweenPredicate() (localctx IIsBetweenPredicateContext) {
 
 	}
 	{
-		p.SetState(121)
+		p.SetState(160)
 		p.Match(CQLParserBETWEEN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2206,11 +2623,11 @@ func (p *CQLParser) IsBetweenPredicate() (localctx IIsBetweenPredicateContext) {
 		}
 	}
 	{
-		p.SetState(122)
+		p.SetState(161)
 		p.scalarExpression(0)
 	}
 	{
-		p.SetState(123)
+		p.SetState(162)
 		p.Match(CQLParserAND)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2218,7 +2635,7 @@ func (p *CQLParser) IsBetweenPredicate() (localctx IIsBetweenPredicateContext) {
 		}
 	}
 	{
-		p.SetState(124)
+		p.SetState(163)
 		p.scalarExpression(0)
 	}
 
@@ -2243,14 +2660,11 @@ type IIsInListPredicateContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PropertyName() IPropertyNameContext
+	AllScalarExpression() []IScalarExpressionContext
+	ScalarExpression(i int) IScalarExpressionContext
 	IN() antlr.TerminalNode
 	LEFTPAREN() antlr.TerminalNode
 	RIGHTPAREN() antlr.TerminalNode
-	AllCharacterLiteral() []ICharacterLiteralContext
-	CharacterLiteral(i int) ICharacterLiteralContext
-	AllNumericLiteral() []INumericLiteralContext
-	NumericLiteral(i int) INumericLiteralContext
 	NOT() antlr.TerminalNode
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
@@ -2290,48 +2704,20 @@ func NewIsInListPredicateContext(parser antlr.Parser, parent antlr.ParserRuleCon
 
 func (s *IsInListPredicateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IsInListPredicateContext) PropertyName() IPropertyNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPropertyNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPropertyNameContext)
-}
-
-func (s *IsInListPredicateContext) IN() antlr.TerminalNode {
-	return s.GetToken(CQLParserIN, 0)
-}
-
-func (s *IsInListPredicateContext) LEFTPAREN() antlr.TerminalNode {
-	return s.GetToken(CQLParserLEFTPAREN, 0)
-}
-
-func (s *IsInListPredicateContext) RIGHTPAREN() antlr.TerminalNode {
-	return s.GetToken(CQLParserRIGHTPAREN, 0)
-}
-
-func (s *IsInListPredicateContext) AllCharacterLiteral() []ICharacterLiteralContext {
+func (s *IsInListPredicateContext) AllScalarExpression() []IScalarExpressionContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ICharacterLiteralContext); ok {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ICharacterLiteralContext, len)
+	tst := make([]IScalarExpressionContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ICharacterLiteralContext); ok {
-			tst[i] = t.(ICharacterLiteralContext)
+		if t, ok := ctx.(IScalarExpressionContext); ok {
+			tst[i] = t.(IScalarExpressionContext)
 			i++
 		}
 	}
@@ -2339,11 +2725,11 @@ func (s *IsInListPredicateContext) AllCharacterLiteral() []ICharacterLiteralCont
 	return tst
 }
 
-func (s *IsInListPredicateContext) CharacterLiteral(i int) ICharacterLiteralContext {
+func (s *IsInListPredicateContext) ScalarExpression(i int) IScalarExpressionContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ICharacterLiteralContext); ok {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -2356,48 +2742,19 @@ func (s *IsInListPredicateContext) CharacterLiteral(i int) ICharacterLiteralCont
 		return nil
 	}
 
-	return t.(ICharacterLiteralContext)
+	return t.(IScalarExpressionContext)
 }
 
-func (s *IsInListPredicateContext) AllNumericLiteral() []INumericLiteralContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(INumericLiteralContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]INumericLiteralContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(INumericLiteralContext); ok {
-			tst[i] = t.(INumericLiteralContext)
-			i++
-		}
-	}
-
-	return tst
+func (s *IsInListPredicateContext) IN() antlr.TerminalNode {
+	return s.GetToken(CQLParserIN, 0)
 }
 
-func (s *IsInListPredicateContext) NumericLiteral(i int) INumericLiteralContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INumericLiteralContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *IsInListPredicateContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
 
-	return t.(INumericLiteralContext)
+func (s *IsInListPredicateContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
 func (s *IsInListPredicateContext) NOT() antlr.TerminalNode {
@@ -2434,15 +2791,15 @@ func (s *IsInListPredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) IsInListPredicate() (localctx IIsInListPredicateContext) {
 	localctx = NewIsInListPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, CQLParserRULE_isInListPredicate)
+	p.EnterRule(localctx, 18, CQLParserRULE_isInListPredicate)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(126)
-		p.PropertyName()
+		p.SetState(165)
+		p.scalarExpression(0)
 	}
-	p.SetState(128)
+	p.SetState(167)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2451,7 +2808,7 @@ func (p *CQLParser) IsInListPredicate() (localctx IIsInListPredicateContext) {
 
 	if _la == CQLParserNOT {
 		{
-			p.SetState(127)
+			p.SetState(166)
 			p.Match(CQLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2461,7 +2818,7 @@ func (p *CQLParser) IsInListPredicate() (localctx IIsInListPredicateContext) {
 
 	}
 	{
-		p.SetState(130)
+		p.SetState(169)
 		p.Match(CQLParserIN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2469,94 +2826,47 @@ func (p *CQLParser) IsInListPredicate() (localctx IIsInListPredicateContext) {
 		}
 	}
 	{
-		p.SetState(131)
+		p.SetState(170)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(148)
+	{
+		p.SetState(171)
+		p.scalarExpression(0)
+	}
+	p.SetState(176)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case CQLParserCharacterStringLiteral:
+	for _la == CQLParserCOMMA {
 		{
-			p.SetState(132)
-			p.CharacterLiteral()
-		}
-		p.SetState(137)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == CQLParserCOMMA {
-			{
-				p.SetState(133)
-				p.Match(CQLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(134)
-				p.CharacterLiteral()
-			}
-
-			p.SetState(139)
-			p.GetErrorHandler().Sync(p)
+			p.SetState(172)
+			p.Match(CQLParserCOMMA)
 			if p.HasError() {
+				// Recognition error - abort rule
 				goto errorExit
 			}
-			_la = p.GetTokenStream().LA(1)
 		}
-
-	case CQLParserNumericLiteral:
 		{
-			p.SetState(140)
-			p.NumericLiteral()
+			p.SetState(173)
+			p.scalarExpression(0)
 		}
-		p.SetState(145)
+
+		p.SetState(178)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-
-		for _la == CQLParserCOMMA {
-			{
-				p.SetState(141)
-				p.Match(CQLParserCOMMA)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(142)
-				p.NumericLiteral()
-			}
-
-			p.SetState(147)
-			p.GetErrorHandler().Sync(p)
-			if p.HasError() {
-				goto errorExit
-			}
-			_la = p.GetTokenStream().LA(1)
-		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 	{
-		p.SetState(150)
+		p.SetState(179)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2585,7 +2895,7 @@ type IIsNullPredicateContext interface {
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	PropertyName() IPropertyNameContext
+	ScalarExpression() IScalarExpressionContext
 	IS() antlr.TerminalNode
 	NULL() antlr.TerminalNode
 	NOT() antlr.TerminalNode
@@ -2625,10 +2935,10 @@ func NewIsNullPredicateContext(parser antlr.Parser, parent antlr.ParserRuleConte
 
 func (s *IsNullPredicateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IsNullPredicateContext) PropertyName() IPropertyNameContext {
+func (s *IsNullPredicateContext) ScalarExpression() IScalarExpressionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPropertyNameContext); ok {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -2638,7 +2948,7 @@ func (s *IsNullPredicateContext) PropertyName() IPropertyNameContext {
 		return nil
 	}
 
-	return t.(IPropertyNameContext)
+	return t.(IScalarExpressionContext)
 }
 
 func (s *IsNullPredicateContext) IS() antlr.TerminalNode {
@@ -2675,23 +2985,23 @@ func (s *IsNullPredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) IsNullPredicate() (localctx IIsNullPredicateContext) {
 	localctx = NewIsNullPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, CQLParserRULE_isNullPredicate)
+	p.EnterRule(localctx, 20, CQLParserRULE_isNullPredicate)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(152)
-		p.PropertyName()
+		p.SetState(181)
+		p.scalarExpression(0)
 	}
 	{
-		p.SetState(153)
+		p.SetState(182)
 		p.Match(CQLParserIS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(155)
+	p.SetState(184)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2700,7 +3010,7 @@ func (p *CQLParser) IsNullPredicate() (localctx IIsNullPredicateContext) {
 
 	if _la == CQLParserNOT {
 		{
-			p.SetState(154)
+			p.SetState(183)
 			p.Match(CQLParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2710,7 +3020,7 @@ func (p *CQLParser) IsNullPredicate() (localctx IIsNullPredicateContext) {
 
 	}
 	{
-		p.SetState(157)
+		p.SetState(186)
 		p.Match(CQLParserNULL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2995,25 +3305,25 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 	localctx = NewScalarExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IScalarExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 20
-	p.EnterRecursionRule(localctx, 20, CQLParserRULE_scalarExpression, _p)
+	_startState := 22
+	p.EnterRecursionRule(localctx, 22, CQLParserRULE_scalarExpression, _p)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(165)
+	p.SetState(194)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case CQLParserBooleanLiteral, CQLParserNumericLiteral, CQLParserIdentifier, CQLParserTemporalLiteral, CQLParserCharacterStringLiteral:
+	case CQLParserBooleanLiteral, CQLParserUNKNOWN, CQLParserENVELOPE, CQLParserCIRCULARSTRING, CQLParserCOMPOUNDCURVE, CQLParserCURVEPOLYGON, CQLParserMULTICURVE, CQLParserMULTISURFACE, CQLParserEMPTY, CQLParserNumericLiteral, CQLParserIdentifier, CQLParserTemporalLiteral, CQLParserCharacterStringLiteral:
 		localctx = NewScalarValContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 
 		{
-			p.SetState(160)
+			p.SetState(189)
 
 			var _x = p.ScalarValue()
 
@@ -3025,7 +3335,7 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(161)
+			p.SetState(190)
 			p.Match(CQLParserLEFTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3033,14 +3343,14 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 			}
 		}
 		{
-			p.SetState(162)
+			p.SetState(191)
 
 			var _x = p.scalarExpression(0)
 
 			localctx.(*ScalarParenContext).expr = _x
 		}
 		{
-			p.SetState(163)
+			p.SetState(192)
 			p.Match(CQLParserRIGHTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3053,12 +3363,12 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(172)
+	p.SetState(201)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -3072,14 +3382,14 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 			localctx.(*ScalarExprContext).left = _prevctx
 
 			p.PushNewRecursionContext(localctx, _startState, CQLParserRULE_scalarExpression)
-			p.SetState(167)
+			p.SetState(196)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				goto errorExit
 			}
 			{
-				p.SetState(168)
+				p.SetState(197)
 
 				var _m = p.Match(CQLParserArithmeticOperator)
 
@@ -3090,7 +3400,7 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 				}
 			}
 			{
-				p.SetState(169)
+				p.SetState(198)
 
 				var _x = p.scalarExpression(2)
 
@@ -3098,12 +3408,12 @@ func (p *CQLParser) scalarExpression(_p int) (localctx IScalarExpressionContext)
 			}
 
 		}
-		p.SetState(174)
+		p.SetState(203)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 14, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -3313,6 +3623,52 @@ func (s *LiteralStringContext) ExitRule(listener antlr.ParseTreeListener) {
 	}
 }
 
+type FunctionValueContext struct {
+	ScalarValueContext
+}
+
+func NewFunctionValueContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FunctionValueContext {
+	var p = new(FunctionValueContext)
+
+	InitEmptyScalarValueContext(&p.ScalarValueContext)
+	p.parser = parser
+	p.CopyAll(ctx.(*ScalarValueContext))
+
+	return p
+}
+
+func (s *FunctionValueContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionValueContext) Function() IFunctionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFunctionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionContext)
+}
+
+func (s *FunctionValueContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterFunctionValue(s)
+	}
+}
+
+func (s *FunctionValueContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitFunctionValue(s)
+	}
+}
+
 type LiteralNameContext struct {
 	ScalarValueContext
 }
@@ -3407,56 +3763,63 @@ func (s *LiteralBooleanContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) ScalarValue() (localctx IScalarValueContext) {
 	localctx = NewScalarValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, CQLParserRULE_scalarValue)
-	p.SetState(180)
+	p.EnterRule(localctx, 24, CQLParserRULE_scalarValue)
+	p.SetState(210)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case CQLParserIdentifier:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	case 1:
 		localctx = NewLiteralNameContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(175)
+			p.SetState(204)
 			p.PropertyName()
 		}
 
-	case CQLParserCharacterStringLiteral:
+	case 2:
 		localctx = NewLiteralStringContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(176)
+			p.SetState(205)
 			p.CharacterLiteral()
 		}
 
-	case CQLParserNumericLiteral:
+	case 3:
 		localctx = NewLiteralNumericContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(177)
+			p.SetState(206)
 			p.NumericLiteral()
 		}
 
-	case CQLParserBooleanLiteral:
+	case 4:
 		localctx = NewLiteralBooleanContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(178)
+			p.SetState(207)
 			p.BooleanLiteral()
 		}
 
-	case CQLParserTemporalLiteral:
+	case 5:
 		localctx = NewLiteralTemporalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(179)
+			p.SetState(208)
 			p.TemporalLiteral()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 6:
+		localctx = NewFunctionValueContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(209)
+			p.Function()
+		}
+
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 
@@ -3482,6 +3845,14 @@ type IPropertyNameContext interface {
 
 	// Getter signatures
 	Identifier() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	UNKNOWN() antlr.TerminalNode
+	ENVELOPE() antlr.TerminalNode
+	CIRCULARSTRING() antlr.TerminalNode
+	COMPOUNDCURVE() antlr.TerminalNode
+	CURVEPOLYGON() antlr.TerminalNode
+	MULTICURVE() antlr.TerminalNode
+	MULTISURFACE() antlr.TerminalNode
 
 	// IsPropertyNameContext differentiates from other interfaces.
 	IsPropertyNameContext()
@@ -3522,6 +3893,38 @@ func (s *PropertyNameContext) Identifier() antlr.TerminalNode {
 	return s.GetToken(CQLParserIdentifier, 0)
 }
 
+func (s *PropertyNameContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *PropertyNameContext) UNKNOWN() antlr.TerminalNode {
+	return s.GetToken(CQLParserUNKNOWN, 0)
+}
+
+func (s *PropertyNameContext) ENVELOPE() antlr.TerminalNode {
+	return s.GetToken(CQLParserENVELOPE, 0)
+}
+
+func (s *PropertyNameContext) CIRCULARSTRING() antlr.TerminalNode {
+	return s.GetToken(CQLParserCIRCULARSTRING, 0)
+}
+
+func (s *PropertyNameContext) COMPOUNDCURVE() antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMPOUNDCURVE, 0)
+}
+
+func (s *PropertyNameContext) CURVEPOLYGON() antlr.TerminalNode {
+	return s.GetToken(CQLParserCURVEPOLYGON, 0)
+}
+
+func (s *PropertyNameContext) MULTICURVE() antlr.TerminalNode {
+	return s.GetToken(CQLParserMULTICURVE, 0)
+}
+
+func (s *PropertyNameContext) MULTISURFACE() antlr.TerminalNode {
+	return s.GetToken(CQLParserMULTISURFACE, 0)
+}
+
 func (s *PropertyNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3544,14 +3947,19 @@ func (s *PropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) PropertyName() (localctx IPropertyNameContext) {
 	localctx = NewPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, CQLParserRULE_propertyName)
+	p.EnterRule(localctx, 26, CQLParserRULE_propertyName)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(182)
-		p.Match(CQLParserIdentifier)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(212)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&1167694495744) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 	}
 
@@ -3568,15 +3976,233 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICharacterLiteralContext is an interface to support dynamic dispatch.
-type ICharacterLiteralContext interface {
+// IFunctionContext is an interface to support dynamic dispatch.
+type IFunctionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	CharacterStringLiteral() antlr.TerminalNode
+	Identifier() antlr.TerminalNode
+	LEFTPAREN() antlr.TerminalNode
+	RIGHTPAREN() antlr.TerminalNode
+	AllScalarExpression() []IScalarExpressionContext
+	ScalarExpression(i int) IScalarExpressionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsFunctionContext differentiates from other interfaces.
+	IsFunctionContext()
+}
+
+type FunctionContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionContext() *FunctionContext {
+	var p = new(FunctionContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_function
+	return p
+}
+
+func InitEmptyFunctionContext(p *FunctionContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_function
+}
+
+func (*FunctionContext) IsFunctionContext() {}
+
+func NewFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionContext {
+	var p = new(FunctionContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_function
+
+	return p
+}
+
+func (s *FunctionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(CQLParserIdentifier, 0)
+}
+
+func (s *FunctionContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
+
+func (s *FunctionContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
+}
+
+func (s *FunctionContext) AllScalarExpression() []IScalarExpressionContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IScalarExpressionContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IScalarExpressionContext); ok {
+			tst[i] = t.(IScalarExpressionContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FunctionContext) ScalarExpression(i int) IScalarExpressionContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IScalarExpressionContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IScalarExpressionContext)
+}
+
+func (s *FunctionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *FunctionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *FunctionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterFunction(s)
+	}
+}
+
+func (s *FunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitFunction(s)
+	}
+}
+
+func (p *CQLParser) Function() (localctx IFunctionContext) {
+	localctx = NewFunctionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, CQLParserRULE_function)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(214)
+		p.Match(CQLParserIdentifier)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(215)
+		p.Match(CQLParserLEFTPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(224)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4504836041343232) != 0) || _la == CQLParserTemporalLiteral || _la == CQLParserCharacterStringLiteral {
+		{
+			p.SetState(216)
+			p.scalarExpression(0)
+		}
+		p.SetState(221)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(217)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(218)
+				p.scalarExpression(0)
+			}
+
+			p.SetState(223)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(226)
+		p.Match(CQLParserRIGHTPAREN)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICharacterLiteralContext is an interface to support dynamic dispatch.
+type ICharacterLiteralContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CharacterStringLiteral() antlr.TerminalNode
 
 	// IsCharacterLiteralContext differentiates from other interfaces.
 	IsCharacterLiteralContext()
@@ -3639,10 +4265,10 @@ func (s *CharacterLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) CharacterLiteral() (localctx ICharacterLiteralContext) {
 	localctx = NewCharacterLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, CQLParserRULE_characterLiteral)
+	p.EnterRule(localctx, 30, CQLParserRULE_characterLiteral)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(184)
+		p.SetState(228)
 		p.Match(CQLParserCharacterStringLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3734,10 +4360,10 @@ func (s *NumericLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) NumericLiteral() (localctx INumericLiteralContext) {
 	localctx = NewNumericLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, CQLParserRULE_numericLiteral)
+	p.EnterRule(localctx, 32, CQLParserRULE_numericLiteral)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(186)
+		p.SetState(230)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3829,10 +4455,10 @@ func (s *BooleanLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
 	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, CQLParserRULE_booleanLiteral)
+	p.EnterRule(localctx, 34, CQLParserRULE_booleanLiteral)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(188)
+		p.SetState(232)
 		p.Match(CQLParserBooleanLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3924,10 +4550,10 @@ func (s *TemporalLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) TemporalLiteral() (localctx ITemporalLiteralContext) {
 	localctx = NewTemporalLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, CQLParserRULE_temporalLiteral)
+	p.EnterRule(localctx, 36, CQLParserRULE_temporalLiteral)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(190)
+		p.SetState(234)
 		p.Match(CQLParserTemporalLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4077,10 +4703,10 @@ func (s *SpatialPredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) SpatialPredicate() (localctx ISpatialPredicateContext) {
 	localctx = NewSpatialPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, CQLParserRULE_spatialPredicate)
+	p.EnterRule(localctx, 38, CQLParserRULE_spatialPredicate)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(192)
+		p.SetState(236)
 		p.Match(CQLParserSpatialOperator)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4088,7 +4714,7 @@ func (p *CQLParser) SpatialPredicate() (localctx ISpatialPredicateContext) {
 		}
 	}
 	{
-		p.SetState(193)
+		p.SetState(237)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4096,11 +4722,11 @@ func (p *CQLParser) SpatialPredicate() (localctx ISpatialPredicateContext) {
 		}
 	}
 	{
-		p.SetState(194)
+		p.SetState(238)
 		p.GeomExpression()
 	}
 	{
-		p.SetState(195)
+		p.SetState(239)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4108,11 +4734,11 @@ func (p *CQLParser) SpatialPredicate() (localctx ISpatialPredicateContext) {
 		}
 	}
 	{
-		p.SetState(196)
+		p.SetState(240)
 		p.GeomExpression()
 	}
 	{
-		p.SetState(197)
+		p.SetState(241)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4272,10 +4898,10 @@ func (s *DistancePredicateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 	localctx = NewDistancePredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, CQLParserRULE_distancePredicate)
+	p.EnterRule(localctx, 40, CQLParserRULE_distancePredicate)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(199)
+		p.SetState(243)
 		p.Match(CQLParserDistanceOperator)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4283,7 +4909,7 @@ func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 		}
 	}
 	{
-		p.SetState(200)
+		p.SetState(244)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4291,11 +4917,11 @@ func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 		}
 	}
 	{
-		p.SetState(201)
+		p.SetState(245)
 		p.GeomExpression()
 	}
 	{
-		p.SetState(202)
+		p.SetState(246)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4303,11 +4929,11 @@ func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 		}
 	}
 	{
-		p.SetState(203)
+		p.SetState(247)
 		p.GeomExpression()
 	}
 	{
-		p.SetState(204)
+		p.SetState(248)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4315,7 +4941,7 @@ func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 		}
 	}
 	{
-		p.SetState(205)
+		p.SetState(249)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4323,7 +4949,7 @@ func (p *CQLParser) DistancePredicate() (localctx IDistancePredicateContext) {
 		}
 	}
 	{
-		p.SetState(206)
+		p.SetState(250)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4354,6 +4980,10 @@ type IGeomExpressionContext interface {
 	// Getter signatures
 	PropertyName() IPropertyNameContext
 	GeomLiteral() IGeomLiteralContext
+	EwktSridPrefix() antlr.TerminalNode
+	WkbHexLiteral() antlr.TerminalNode
+	NumericLiteral() antlr.TerminalNode
+	GeoJsonLiteral() antlr.TerminalNode
 
 	// IsGeomExpressionContext differentiates from other interfaces.
 	IsGeomExpressionContext()
@@ -4422,6 +5052,22 @@ func (s *GeomExpressionContext) GeomLiteral() IGeomLiteralContext {
 	return t.(IGeomLiteralContext)
 }
 
+func (s *GeomExpressionContext) EwktSridPrefix() antlr.TerminalNode {
+	return s.GetToken(CQLParserEwktSridPrefix, 0)
+}
+
+func (s *GeomExpressionContext) WkbHexLiteral() antlr.TerminalNode {
+	return s.GetToken(CQLParserWkbHexLiteral, 0)
+}
+
+func (s *GeomExpressionContext) NumericLiteral() antlr.TerminalNode {
+	return s.GetToken(CQLParserNumericLiteral, 0)
+}
+
+func (s *GeomExpressionContext) GeoJsonLiteral() antlr.TerminalNode {
+	return s.GetToken(CQLParserGeoJsonLiteral, 0)
+}
+
 func (s *GeomExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4444,30 +5090,74 @@ func (s *GeomExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) GeomExpression() (localctx IGeomExpressionContext) {
 	localctx = NewGeomExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, CQLParserRULE_geomExpression)
-	p.SetState(210)
+	p.EnterRule(localctx, 42, CQLParserRULE_geomExpression)
+	var _la int
+
+	p.SetState(259)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetTokenStream().LA(1) {
-	case CQLParserIdentifier:
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(208)
+			p.SetState(252)
 			p.PropertyName()
 		}
 
-	case CQLParserPOINT, CQLParserLINESTRING, CQLParserPOLYGON, CQLParserMULTIPOINT, CQLParserMULTILINESTRING, CQLParserMULTIPOLYGON, CQLParserGEOMETRYCOLLECTION, CQLParserENVELOPE:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
+		p.SetState(254)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CQLParserEwktSridPrefix {
+			{
+				p.SetState(253)
+				p.Match(CQLParserEwktSridPrefix)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
 		{
-			p.SetState(209)
+			p.SetState(256)
 			p.GeomLiteral()
 		}
 
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(257)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == CQLParserNumericLiteral || _la == CQLParserWkbHexLiteral) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(258)
+			p.Match(CQLParserGeoJsonLiteral)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
 
@@ -4499,6 +5189,11 @@ type IGeomLiteralContext interface {
 	MultiLinestring() IMultiLinestringContext
 	MultiPolygon() IMultiPolygonContext
 	GeometryCollection() IGeometryCollectionContext
+	CircularString() ICircularStringContext
+	CompoundCurve() ICompoundCurveContext
+	CurvePolygon() ICurvePolygonContext
+	MultiCurve() IMultiCurveContext
+	MultiSurface() IMultiSurfaceContext
 	Envelope() IEnvelopeContext
 
 	// IsGeomLiteralContext differentiates from other interfaces.
@@ -4648,10 +5343,10 @@ func (s *GeomLiteralContext) GeometryCollection() IGeometryCollectionContext {
 	return t.(IGeometryCollectionContext)
 }
 
-func (s *GeomLiteralContext) Envelope() IEnvelopeContext {
+func (s *GeomLiteralContext) CircularString() ICircularStringContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEnvelopeContext); ok {
+		if _, ok := ctx.(ICircularStringContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -4661,20 +5356,100 @@ func (s *GeomLiteralContext) Envelope() IEnvelopeContext {
 		return nil
 	}
 
-	return t.(IEnvelopeContext)
+	return t.(ICircularStringContext)
 }
 
-func (s *GeomLiteralContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *GeomLiteralContext) CompoundCurve() ICompoundCurveContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompoundCurveContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *GeomLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompoundCurveContext)
 }
 
-func (s *GeomLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(CQLParserListener); ok {
-		listenerT.EnterGeomLiteral(s)
+func (s *GeomLiteralContext) CurvePolygon() ICurvePolygonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICurvePolygonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICurvePolygonContext)
+}
+
+func (s *GeomLiteralContext) MultiCurve() IMultiCurveContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMultiCurveContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMultiCurveContext)
+}
+
+func (s *GeomLiteralContext) MultiSurface() IMultiSurfaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMultiSurfaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMultiSurfaceContext)
+}
+
+func (s *GeomLiteralContext) Envelope() IEnvelopeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEnvelopeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnvelopeContext)
+}
+
+func (s *GeomLiteralContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GeomLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GeomLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterGeomLiteral(s)
 	}
 }
 
@@ -4686,8 +5461,8 @@ func (s *GeomLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) GeomLiteral() (localctx IGeomLiteralContext) {
 	localctx = NewGeomLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, CQLParserRULE_geomLiteral)
-	p.SetState(220)
+	p.EnterRule(localctx, 44, CQLParserRULE_geomLiteral)
+	p.SetState(274)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4697,56 +5472,91 @@ func (p *CQLParser) GeomLiteral() (localctx IGeomLiteralContext) {
 	case CQLParserPOINT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(212)
+			p.SetState(261)
 			p.Point()
 		}
 
 	case CQLParserLINESTRING:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(213)
+			p.SetState(262)
 			p.Linestring()
 		}
 
 	case CQLParserPOLYGON:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(214)
+			p.SetState(263)
 			p.Polygon()
 		}
 
 	case CQLParserMULTIPOINT:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(215)
+			p.SetState(264)
 			p.MultiPoint()
 		}
 
 	case CQLParserMULTILINESTRING:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(216)
+			p.SetState(265)
 			p.MultiLinestring()
 		}
 
 	case CQLParserMULTIPOLYGON:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(217)
+			p.SetState(266)
 			p.MultiPolygon()
 		}
 
 	case CQLParserGEOMETRYCOLLECTION:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(218)
+			p.SetState(267)
 			p.GeometryCollection()
 		}
 
-	case CQLParserENVELOPE:
+	case CQLParserCIRCULARSTRING:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(219)
+			p.SetState(268)
+			p.CircularString()
+		}
+
+	case CQLParserCOMPOUNDCURVE:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(269)
+			p.CompoundCurve()
+		}
+
+	case CQLParserCURVEPOLYGON:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(270)
+			p.CurvePolygon()
+		}
+
+	case CQLParserMULTICURVE:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(271)
+			p.MultiCurve()
+		}
+
+	case CQLParserMULTISURFACE:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(272)
+			p.MultiSurface()
+		}
+
+	case CQLParserENVELOPE:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(273)
 			p.Envelope()
 		}
 
@@ -4778,6 +5588,8 @@ type IPointContext interface {
 	// Getter signatures
 	POINT() antlr.TerminalNode
 	PointList() IPointListContext
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 
 	// IsPointContext differentiates from other interfaces.
 	IsPointContext()
@@ -4834,6 +5646,26 @@ func (s *PointContext) PointList() IPointListContext {
 	return t.(IPointListContext)
 }
 
+func (s *PointContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *PointContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
 func (s *PointContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -4856,19 +5688,58 @@ func (s *PointContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Point() (localctx IPointContext) {
 	localctx = NewPointContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, CQLParserRULE_point)
+	p.EnterRule(localctx, 46, CQLParserRULE_point)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(222)
+		p.SetState(276)
 		p.Match(CQLParserPOINT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(223)
-		p.PointList()
+	p.SetState(278)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(277)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(282)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(280)
+			p.PointList()
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(281)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -4977,10 +5848,10 @@ func (s *PointListContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) PointList() (localctx IPointListContext) {
 	localctx = NewPointListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, CQLParserRULE_pointList)
+	p.EnterRule(localctx, 48, CQLParserRULE_pointList)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(225)
+		p.SetState(284)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4988,11 +5859,11 @@ func (p *CQLParser) PointList() (localctx IPointListContext) {
 		}
 	}
 	{
-		p.SetState(226)
+		p.SetState(285)
 		p.Coordinate()
 	}
 	{
-		p.SetState(227)
+		p.SetState(286)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5023,6 +5894,8 @@ type ILinestringContext interface {
 	// Getter signatures
 	LINESTRING() antlr.TerminalNode
 	CoordList() ICoordListContext
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 
 	// IsLinestringContext differentiates from other interfaces.
 	IsLinestringContext()
@@ -5079,6 +5952,26 @@ func (s *LinestringContext) CoordList() ICoordListContext {
 	return t.(ICoordListContext)
 }
 
+func (s *LinestringContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *LinestringContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
 func (s *LinestringContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5101,19 +5994,58 @@ func (s *LinestringContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Linestring() (localctx ILinestringContext) {
 	localctx = NewLinestringContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, CQLParserRULE_linestring)
+	p.EnterRule(localctx, 50, CQLParserRULE_linestring)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(229)
+		p.SetState(288)
 		p.Match(CQLParserLINESTRING)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(230)
-		p.CoordList()
+	p.SetState(290)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(289)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(294)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(292)
+			p.CoordList()
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(293)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5139,6 +6071,8 @@ type IPolygonContext interface {
 	// Getter signatures
 	POLYGON() antlr.TerminalNode
 	PolygonDef() IPolygonDefContext
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 
 	// IsPolygonContext differentiates from other interfaces.
 	IsPolygonContext()
@@ -5195,6 +6129,26 @@ func (s *PolygonContext) PolygonDef() IPolygonDefContext {
 	return t.(IPolygonDefContext)
 }
 
+func (s *PolygonContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *PolygonContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
 func (s *PolygonContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -5217,19 +6171,58 @@ func (s *PolygonContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Polygon() (localctx IPolygonContext) {
 	localctx = NewPolygonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, CQLParserRULE_polygon)
+	p.EnterRule(localctx, 52, CQLParserRULE_polygon)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(232)
+		p.SetState(296)
 		p.Match(CQLParserPOLYGON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(233)
-		p.PolygonDef()
+	p.SetState(298)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(297)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(302)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(300)
+			p.PolygonDef()
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(301)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5374,12 +6367,12 @@ func (s *PolygonDefContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) PolygonDef() (localctx IPolygonDefContext) {
 	localctx = NewPolygonDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, CQLParserRULE_polygonDef)
+	p.EnterRule(localctx, 54, CQLParserRULE_polygonDef)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(235)
+		p.SetState(304)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5387,10 +6380,10 @@ func (p *CQLParser) PolygonDef() (localctx IPolygonDefContext) {
 		}
 	}
 	{
-		p.SetState(236)
+		p.SetState(305)
 		p.CoordList()
 	}
-	p.SetState(241)
+	p.SetState(310)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5399,7 +6392,7 @@ func (p *CQLParser) PolygonDef() (localctx IPolygonDefContext) {
 
 	for _la == CQLParserCOMMA {
 		{
-			p.SetState(237)
+			p.SetState(306)
 			p.Match(CQLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5407,11 +6400,11 @@ func (p *CQLParser) PolygonDef() (localctx IPolygonDefContext) {
 			}
 		}
 		{
-			p.SetState(238)
+			p.SetState(307)
 			p.CoordList()
 		}
 
-		p.SetState(243)
+		p.SetState(312)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5419,7 +6412,7 @@ func (p *CQLParser) PolygonDef() (localctx IPolygonDefContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(244)
+		p.SetState(313)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5453,6 +6446,8 @@ type IMultiPointContext interface {
 	AllPointList() []IPointListContext
 	PointList(i int) IPointListContext
 	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
@@ -5544,16 +6539,36 @@ func (s *MultiPointContext) RIGHTPAREN() antlr.TerminalNode {
 	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
-func (s *MultiPointContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(CQLParserCOMMA)
-}
-
-func (s *MultiPointContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(CQLParserCOMMA, i)
-}
-
-func (s *MultiPointContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *MultiPointContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *MultiPointContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *MultiPointContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *MultiPointContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *MultiPointContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
 func (s *MultiPointContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
@@ -5574,65 +6589,102 @@ func (s *MultiPointContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) MultiPoint() (localctx IMultiPointContext) {
 	localctx = NewMultiPointContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, CQLParserRULE_multiPoint)
+	p.EnterRule(localctx, 56, CQLParserRULE_multiPoint)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(246)
+		p.SetState(315)
 		p.Match(CQLParserMULTIPOINT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(247)
-		p.Match(CQLParserLEFTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	p.SetState(317)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(248)
-		p.PointList()
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(316)
+			p.Dimension()
+		}
+
 	}
-	p.SetState(253)
+	p.SetState(331)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == CQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
 		{
-			p.SetState(249)
-			p.Match(CQLParserCOMMA)
+			p.SetState(319)
+			p.Match(CQLParserLEFTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(250)
+			p.SetState(320)
 			p.PointList()
 		}
-
-		p.SetState(255)
+		p.SetState(325)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(256)
-		p.Match(CQLParserRIGHTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(321)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(322)
+				p.PointList()
+			}
+
+			p.SetState(327)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(328)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(330)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5661,6 +6713,8 @@ type IMultiLinestringContext interface {
 	AllCoordList() []ICoordListContext
 	CoordList(i int) ICoordListContext
 	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
@@ -5752,6 +6806,26 @@ func (s *MultiLinestringContext) RIGHTPAREN() antlr.TerminalNode {
 	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
+func (s *MultiLinestringContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *MultiLinestringContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
 func (s *MultiLinestringContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(CQLParserCOMMA)
 }
@@ -5782,65 +6856,102 @@ func (s *MultiLinestringContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) MultiLinestring() (localctx IMultiLinestringContext) {
 	localctx = NewMultiLinestringContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, CQLParserRULE_multiLinestring)
+	p.EnterRule(localctx, 58, CQLParserRULE_multiLinestring)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(258)
+		p.SetState(333)
 		p.Match(CQLParserMULTILINESTRING)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(259)
-		p.Match(CQLParserLEFTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	p.SetState(335)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(260)
-		p.CoordList()
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(334)
+			p.Dimension()
+		}
+
 	}
-	p.SetState(265)
+	p.SetState(349)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == CQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
 		{
-			p.SetState(261)
-			p.Match(CQLParserCOMMA)
+			p.SetState(337)
+			p.Match(CQLParserLEFTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(262)
+			p.SetState(338)
 			p.CoordList()
 		}
-
-		p.SetState(267)
+		p.SetState(343)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(268)
-		p.Match(CQLParserRIGHTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(339)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(340)
+				p.CoordList()
+			}
+
+			p.SetState(345)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(346)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(348)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -5869,6 +6980,8 @@ type IMultiPolygonContext interface {
 	AllPolygonDef() []IPolygonDefContext
 	PolygonDef(i int) IPolygonDefContext
 	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
@@ -5960,6 +7073,26 @@ func (s *MultiPolygonContext) RIGHTPAREN() antlr.TerminalNode {
 	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
+func (s *MultiPolygonContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *MultiPolygonContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
 func (s *MultiPolygonContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(CQLParserCOMMA)
 }
@@ -5990,65 +7123,102 @@ func (s *MultiPolygonContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) MultiPolygon() (localctx IMultiPolygonContext) {
 	localctx = NewMultiPolygonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, CQLParserRULE_multiPolygon)
+	p.EnterRule(localctx, 60, CQLParserRULE_multiPolygon)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(270)
+		p.SetState(351)
 		p.Match(CQLParserMULTIPOLYGON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(271)
-		p.Match(CQLParserLEFTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	p.SetState(353)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	{
-		p.SetState(272)
-		p.PolygonDef()
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(352)
+			p.Dimension()
+		}
+
 	}
-	p.SetState(277)
+	p.SetState(367)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == CQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
 		{
-			p.SetState(273)
-			p.Match(CQLParserCOMMA)
+			p.SetState(355)
+			p.Match(CQLParserLEFTPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(274)
+			p.SetState(356)
 			p.PolygonDef()
 		}
-
-		p.SetState(279)
+		p.SetState(361)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(280)
-		p.Match(CQLParserRIGHTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(357)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(358)
+				p.PolygonDef()
+			}
+
+			p.SetState(363)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(364)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(366)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -6077,6 +7247,8 @@ type IGeometryCollectionContext interface {
 	AllGeomLiteral() []IGeomLiteralContext
 	GeomLiteral(i int) IGeomLiteralContext
 	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
 	AllCOMMA() []antlr.TerminalNode
 	COMMA(i int) antlr.TerminalNode
 
@@ -6168,95 +7340,1841 @@ func (s *GeometryCollectionContext) RIGHTPAREN() antlr.TerminalNode {
 	return s.GetToken(CQLParserRIGHTPAREN, 0)
 }
 
-func (s *GeometryCollectionContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(CQLParserCOMMA)
+func (s *GeometryCollectionContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
 }
 
-func (s *GeometryCollectionContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(CQLParserCOMMA, i)
-}
+func (s *GeometryCollectionContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *GeometryCollectionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *GeometryCollectionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *GeometryCollectionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GeometryCollectionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GeometryCollectionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterGeometryCollection(s)
+	}
+}
+
+func (s *GeometryCollectionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitGeometryCollection(s)
+	}
+}
+
+func (p *CQLParser) GeometryCollection() (localctx IGeometryCollectionContext) {
+	localctx = NewGeometryCollectionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, CQLParserRULE_geometryCollection)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(369)
+		p.Match(CQLParserGEOMETRYCOLLECTION)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(371)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(370)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(385)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(373)
+			p.Match(CQLParserLEFTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(374)
+			p.GeomLiteral()
+		}
+		p.SetState(379)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(375)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(376)
+				p.GeomLiteral()
+			}
+
+			p.SetState(381)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(382)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(384)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICircularStringContext is an interface to support dynamic dispatch.
+type ICircularStringContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CIRCULARSTRING() antlr.TerminalNode
+	CoordList() ICoordListContext
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
+
+	// IsCircularStringContext differentiates from other interfaces.
+	IsCircularStringContext()
+}
+
+type CircularStringContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyCircularStringContext() *CircularStringContext {
+	var p = new(CircularStringContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_circularString
+	return p
+}
+
+func InitEmptyCircularStringContext(p *CircularStringContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_circularString
+}
+
+func (*CircularStringContext) IsCircularStringContext() {}
+
+func NewCircularStringContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CircularStringContext {
+	var p = new(CircularStringContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_circularString
+
+	return p
+}
+
+func (s *CircularStringContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CircularStringContext) CIRCULARSTRING() antlr.TerminalNode {
+	return s.GetToken(CQLParserCIRCULARSTRING, 0)
+}
+
+func (s *CircularStringContext) CoordList() ICoordListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICoordListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICoordListContext)
+}
+
+func (s *CircularStringContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *CircularStringContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *CircularStringContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CircularStringContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CircularStringContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterCircularString(s)
+	}
+}
+
+func (s *CircularStringContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitCircularString(s)
+	}
+}
+
+func (p *CQLParser) CircularString() (localctx ICircularStringContext) {
+	localctx = NewCircularStringContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, CQLParserRULE_circularString)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(387)
+		p.Match(CQLParserCIRCULARSTRING)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(389)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(388)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(393)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(391)
+			p.CoordList()
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(392)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICompoundCurveContext is an interface to support dynamic dispatch.
+type ICompoundCurveContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	COMPOUNDCURVE() antlr.TerminalNode
+	LEFTPAREN() antlr.TerminalNode
+	AllCurveMember() []ICurveMemberContext
+	CurveMember(i int) ICurveMemberContext
+	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsCompoundCurveContext differentiates from other interfaces.
+	IsCompoundCurveContext()
+}
+
+type CompoundCurveContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompoundCurveContext() *CompoundCurveContext {
+	var p = new(CompoundCurveContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_compoundCurve
+	return p
+}
+
+func InitEmptyCompoundCurveContext(p *CompoundCurveContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_compoundCurve
+}
+
+func (*CompoundCurveContext) IsCompoundCurveContext() {}
+
+func NewCompoundCurveContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompoundCurveContext {
+	var p = new(CompoundCurveContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_compoundCurve
+
+	return p
+}
+
+func (s *CompoundCurveContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompoundCurveContext) COMPOUNDCURVE() antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMPOUNDCURVE, 0)
+}
+
+func (s *CompoundCurveContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
+
+func (s *CompoundCurveContext) AllCurveMember() []ICurveMemberContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICurveMemberContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICurveMemberContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICurveMemberContext); ok {
+			tst[i] = t.(ICurveMemberContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CompoundCurveContext) CurveMember(i int) ICurveMemberContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICurveMemberContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICurveMemberContext)
+}
+
+func (s *CompoundCurveContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
+}
+
+func (s *CompoundCurveContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *CompoundCurveContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *CompoundCurveContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *CompoundCurveContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *CompoundCurveContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompoundCurveContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompoundCurveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterCompoundCurve(s)
+	}
+}
+
+func (s *CompoundCurveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitCompoundCurve(s)
+	}
+}
+
+func (p *CQLParser) CompoundCurve() (localctx ICompoundCurveContext) {
+	localctx = NewCompoundCurveContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, CQLParserRULE_compoundCurve)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(395)
+		p.Match(CQLParserCOMPOUNDCURVE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(397)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(396)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(411)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(399)
+			p.Match(CQLParserLEFTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(400)
+			p.CurveMember()
+		}
+		p.SetState(405)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(401)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(402)
+				p.CurveMember()
+			}
+
+			p.SetState(407)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(408)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(410)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICurveMemberContext is an interface to support dynamic dispatch.
+type ICurveMemberContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CoordList() ICoordListContext
+	CircularString() ICircularStringContext
+
+	// IsCurveMemberContext differentiates from other interfaces.
+	IsCurveMemberContext()
+}
+
+type CurveMemberContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyCurveMemberContext() *CurveMemberContext {
+	var p = new(CurveMemberContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curveMember
+	return p
+}
+
+func InitEmptyCurveMemberContext(p *CurveMemberContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curveMember
+}
+
+func (*CurveMemberContext) IsCurveMemberContext() {}
+
+func NewCurveMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CurveMemberContext {
+	var p = new(CurveMemberContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_curveMember
+
+	return p
+}
+
+func (s *CurveMemberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CurveMemberContext) CoordList() ICoordListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICoordListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICoordListContext)
+}
+
+func (s *CurveMemberContext) CircularString() ICircularStringContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICircularStringContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICircularStringContext)
+}
+
+func (s *CurveMemberContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CurveMemberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CurveMemberContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterCurveMember(s)
+	}
+}
+
+func (s *CurveMemberContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitCurveMember(s)
+	}
+}
+
+func (p *CQLParser) CurveMember() (localctx ICurveMemberContext) {
+	localctx = NewCurveMemberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, CQLParserRULE_curveMember)
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(413)
+			p.CoordList()
+		}
+
+	case CQLParserCIRCULARSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(414)
+			p.CircularString()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICurvePolygonContext is an interface to support dynamic dispatch.
+type ICurvePolygonContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CURVEPOLYGON() antlr.TerminalNode
+	LEFTPAREN() antlr.TerminalNode
+	AllCurveRing() []ICurveRingContext
+	CurveRing(i int) ICurveRingContext
+	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsCurvePolygonContext differentiates from other interfaces.
+	IsCurvePolygonContext()
+}
+
+type CurvePolygonContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyCurvePolygonContext() *CurvePolygonContext {
+	var p = new(CurvePolygonContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curvePolygon
+	return p
+}
+
+func InitEmptyCurvePolygonContext(p *CurvePolygonContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curvePolygon
+}
+
+func (*CurvePolygonContext) IsCurvePolygonContext() {}
+
+func NewCurvePolygonContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CurvePolygonContext {
+	var p = new(CurvePolygonContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_curvePolygon
+
+	return p
+}
+
+func (s *CurvePolygonContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CurvePolygonContext) CURVEPOLYGON() antlr.TerminalNode {
+	return s.GetToken(CQLParserCURVEPOLYGON, 0)
+}
+
+func (s *CurvePolygonContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
+
+func (s *CurvePolygonContext) AllCurveRing() []ICurveRingContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICurveRingContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICurveRingContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICurveRingContext); ok {
+			tst[i] = t.(ICurveRingContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CurvePolygonContext) CurveRing(i int) ICurveRingContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICurveRingContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICurveRingContext)
+}
+
+func (s *CurvePolygonContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
+}
+
+func (s *CurvePolygonContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *CurvePolygonContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *CurvePolygonContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *CurvePolygonContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *CurvePolygonContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CurvePolygonContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CurvePolygonContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterCurvePolygon(s)
+	}
+}
+
+func (s *CurvePolygonContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitCurvePolygon(s)
+	}
+}
+
+func (p *CQLParser) CurvePolygon() (localctx ICurvePolygonContext) {
+	localctx = NewCurvePolygonContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, CQLParserRULE_curvePolygon)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(417)
+		p.Match(CQLParserCURVEPOLYGON)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(419)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(418)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(433)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(421)
+			p.Match(CQLParserLEFTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(422)
+			p.CurveRing()
+		}
+		p.SetState(427)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(423)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(424)
+				p.CurveRing()
+			}
+
+			p.SetState(429)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(430)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(432)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICurveRingContext is an interface to support dynamic dispatch.
+type ICurveRingContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CoordList() ICoordListContext
+	CircularString() ICircularStringContext
+	CompoundCurve() ICompoundCurveContext
+
+	// IsCurveRingContext differentiates from other interfaces.
+	IsCurveRingContext()
+}
+
+type CurveRingContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyCurveRingContext() *CurveRingContext {
+	var p = new(CurveRingContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curveRing
+	return p
+}
+
+func InitEmptyCurveRingContext(p *CurveRingContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_curveRing
+}
+
+func (*CurveRingContext) IsCurveRingContext() {}
+
+func NewCurveRingContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CurveRingContext {
+	var p = new(CurveRingContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_curveRing
+
+	return p
+}
+
+func (s *CurveRingContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CurveRingContext) CoordList() ICoordListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICoordListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICoordListContext)
+}
+
+func (s *CurveRingContext) CircularString() ICircularStringContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICircularStringContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICircularStringContext)
+}
+
+func (s *CurveRingContext) CompoundCurve() ICompoundCurveContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompoundCurveContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompoundCurveContext)
+}
+
+func (s *CurveRingContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CurveRingContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CurveRingContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterCurveRing(s)
+	}
+}
+
+func (s *CurveRingContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitCurveRing(s)
+	}
+}
+
+func (p *CQLParser) CurveRing() (localctx ICurveRingContext) {
+	localctx = NewCurveRingContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, CQLParserRULE_curveRing)
+	p.SetState(438)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(435)
+			p.CoordList()
+		}
+
+	case CQLParserCIRCULARSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(436)
+			p.CircularString()
+		}
+
+	case CQLParserCOMPOUNDCURVE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(437)
+			p.CompoundCurve()
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMultiCurveContext is an interface to support dynamic dispatch.
+type IMultiCurveContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MULTICURVE() antlr.TerminalNode
+	LEFTPAREN() antlr.TerminalNode
+	AllCurveRing() []ICurveRingContext
+	CurveRing(i int) ICurveRingContext
+	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsMultiCurveContext differentiates from other interfaces.
+	IsMultiCurveContext()
+}
+
+type MultiCurveContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyMultiCurveContext() *MultiCurveContext {
+	var p = new(MultiCurveContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_multiCurve
+	return p
+}
+
+func InitEmptyMultiCurveContext(p *MultiCurveContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_multiCurve
+}
+
+func (*MultiCurveContext) IsMultiCurveContext() {}
+
+func NewMultiCurveContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiCurveContext {
+	var p = new(MultiCurveContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_multiCurve
+
+	return p
+}
+
+func (s *MultiCurveContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MultiCurveContext) MULTICURVE() antlr.TerminalNode {
+	return s.GetToken(CQLParserMULTICURVE, 0)
+}
+
+func (s *MultiCurveContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
+
+func (s *MultiCurveContext) AllCurveRing() []ICurveRingContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICurveRingContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICurveRingContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICurveRingContext); ok {
+			tst[i] = t.(ICurveRingContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiCurveContext) CurveRing(i int) ICurveRingContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICurveRingContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICurveRingContext)
+}
+
+func (s *MultiCurveContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
+}
+
+func (s *MultiCurveContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *MultiCurveContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *MultiCurveContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *MultiCurveContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *MultiCurveContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MultiCurveContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MultiCurveContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterMultiCurve(s)
+	}
+}
+
+func (s *MultiCurveContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitMultiCurve(s)
+	}
+}
+
+func (p *CQLParser) MultiCurve() (localctx IMultiCurveContext) {
+	localctx = NewMultiCurveContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, CQLParserRULE_multiCurve)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(440)
+		p.Match(CQLParserMULTICURVE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(442)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(441)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(456)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(444)
+			p.Match(CQLParserLEFTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(445)
+			p.CurveRing()
+		}
+		p.SetState(450)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(446)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(447)
+				p.CurveRing()
+			}
+
+			p.SetState(452)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(453)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(455)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMultiSurfaceContext is an interface to support dynamic dispatch.
+type IMultiSurfaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MULTISURFACE() antlr.TerminalNode
+	LEFTPAREN() antlr.TerminalNode
+	AllSurfaceMember() []ISurfaceMemberContext
+	SurfaceMember(i int) ISurfaceMemberContext
+	RIGHTPAREN() antlr.TerminalNode
+	EMPTY() antlr.TerminalNode
+	Dimension() IDimensionContext
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsMultiSurfaceContext differentiates from other interfaces.
+	IsMultiSurfaceContext()
+}
+
+type MultiSurfaceContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyMultiSurfaceContext() *MultiSurfaceContext {
+	var p = new(MultiSurfaceContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_multiSurface
+	return p
+}
+
+func InitEmptyMultiSurfaceContext(p *MultiSurfaceContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_multiSurface
+}
+
+func (*MultiSurfaceContext) IsMultiSurfaceContext() {}
+
+func NewMultiSurfaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultiSurfaceContext {
+	var p = new(MultiSurfaceContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_multiSurface
+
+	return p
+}
+
+func (s *MultiSurfaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MultiSurfaceContext) MULTISURFACE() antlr.TerminalNode {
+	return s.GetToken(CQLParserMULTISURFACE, 0)
+}
+
+func (s *MultiSurfaceContext) LEFTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserLEFTPAREN, 0)
+}
+
+func (s *MultiSurfaceContext) AllSurfaceMember() []ISurfaceMemberContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISurfaceMemberContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISurfaceMemberContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISurfaceMemberContext); ok {
+			tst[i] = t.(ISurfaceMemberContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MultiSurfaceContext) SurfaceMember(i int) ISurfaceMemberContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISurfaceMemberContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISurfaceMemberContext)
+}
+
+func (s *MultiSurfaceContext) RIGHTPAREN() antlr.TerminalNode {
+	return s.GetToken(CQLParserRIGHTPAREN, 0)
+}
+
+func (s *MultiSurfaceContext) EMPTY() antlr.TerminalNode {
+	return s.GetToken(CQLParserEMPTY, 0)
+}
+
+func (s *MultiSurfaceContext) Dimension() IDimensionContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDimensionContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDimensionContext)
+}
+
+func (s *MultiSurfaceContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(CQLParserCOMMA)
+}
+
+func (s *MultiSurfaceContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(CQLParserCOMMA, i)
+}
+
+func (s *MultiSurfaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MultiSurfaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MultiSurfaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterMultiSurface(s)
+	}
+}
+
+func (s *MultiSurfaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitMultiSurface(s)
+	}
+}
+
+func (p *CQLParser) MultiSurface() (localctx IMultiSurfaceContext) {
+	localctx = NewMultiSurfaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, CQLParserRULE_multiSurface)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(458)
+		p.Match(CQLParserMULTISURFACE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(460)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserIdentifier {
+		{
+			p.SetState(459)
+			p.Dimension()
+		}
+
+	}
+	p.SetState(474)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		{
+			p.SetState(462)
+			p.Match(CQLParserLEFTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(463)
+			p.SurfaceMember()
+		}
+		p.SetState(468)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == CQLParserCOMMA {
+			{
+				p.SetState(464)
+				p.Match(CQLParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(465)
+				p.SurfaceMember()
+			}
+
+			p.SetState(470)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(471)
+			p.Match(CQLParserRIGHTPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case CQLParserEMPTY:
+		{
+			p.SetState(473)
+			p.Match(CQLParserEMPTY)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-func (s *GeometryCollectionContext) GetRuleContext() antlr.RuleContext {
-	return s
+// ISurfaceMemberContext is an interface to support dynamic dispatch.
+type ISurfaceMemberContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	PolygonDef() IPolygonDefContext
+	CurvePolygon() ICurvePolygonContext
+
+	// IsSurfaceMemberContext differentiates from other interfaces.
+	IsSurfaceMemberContext()
 }
 
-func (s *GeometryCollectionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+type SurfaceMemberContext struct {
+	*CqlContext
+	parser antlr.Parser
 }
 
-func (s *GeometryCollectionContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(CQLParserListener); ok {
-		listenerT.EnterGeometryCollection(s)
-	}
+func NewEmptySurfaceMemberContext() *SurfaceMemberContext {
+	var p = new(SurfaceMemberContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_surfaceMember
+	return p
 }
 
-func (s *GeometryCollectionContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(CQLParserListener); ok {
-		listenerT.ExitGeometryCollection(s)
-	}
+func InitEmptySurfaceMemberContext(p *SurfaceMemberContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_surfaceMember
 }
 
-func (p *CQLParser) GeometryCollection() (localctx IGeometryCollectionContext) {
-	localctx = NewGeometryCollectionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, CQLParserRULE_geometryCollection)
-	var _la int
+func (*SurfaceMemberContext) IsSurfaceMemberContext() {}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(282)
-		p.Match(CQLParserGEOMETRYCOLLECTION)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+func NewSurfaceMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SurfaceMemberContext {
+	var p = new(SurfaceMemberContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_surfaceMember
+
+	return p
+}
+
+func (s *SurfaceMemberContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SurfaceMemberContext) PolygonDef() IPolygonDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPolygonDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
-	{
-		p.SetState(283)
-		p.Match(CQLParserLEFTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPolygonDefContext)
+}
+
+func (s *SurfaceMemberContext) CurvePolygon() ICurvePolygonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICurvePolygonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
-	{
-		p.SetState(284)
-		p.GeomLiteral()
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICurvePolygonContext)
+}
+
+func (s *SurfaceMemberContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SurfaceMemberContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SurfaceMemberContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterSurfaceMember(s)
+	}
+}
+
+func (s *SurfaceMemberContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitSurfaceMember(s)
 	}
-	p.SetState(289)
+}
+
+func (p *CQLParser) SurfaceMember() (localctx ISurfaceMemberContext) {
+	localctx = NewSurfaceMemberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, CQLParserRULE_surfaceMember)
+	p.SetState(478)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == CQLParserCOMMA {
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserLEFTPAREN:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(285)
-			p.Match(CQLParserCOMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(476)
+			p.PolygonDef()
 		}
+
+	case CQLParserCURVEPOLYGON:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(286)
-			p.GeomLiteral()
+			p.SetState(477)
+			p.CurvePolygon()
 		}
 
-		p.SetState(291)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(292)
-		p.Match(CQLParserRIGHTPAREN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -6373,10 +9291,12 @@ func (s *EnvelopeContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 	localctx = NewEnvelopeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, CQLParserRULE_envelope)
+	p.EnterRule(localctx, 80, CQLParserRULE_envelope)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(294)
+		p.SetState(480)
 		p.Match(CQLParserENVELOPE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6384,7 +9304,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(295)
+		p.SetState(481)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6392,7 +9312,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(296)
+		p.SetState(482)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6400,7 +9320,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(297)
+		p.SetState(483)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6408,7 +9328,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(298)
+		p.SetState(484)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6416,7 +9336,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(299)
+		p.SetState(485)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6424,7 +9344,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(300)
+		p.SetState(486)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6432,7 +9352,7 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(301)
+		p.SetState(487)
 		p.Match(CQLParserCOMMA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6440,15 +9360,57 @@ func (p *CQLParser) Envelope() (localctx IEnvelopeContext) {
 		}
 	}
 	{
-		p.SetState(302)
+		p.SetState(488)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(493)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserCOMMA {
+		{
+			p.SetState(489)
+			p.Match(CQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(490)
+			p.Match(CQLParserNumericLiteral)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(491)
+			p.Match(CQLParserCOMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(492)
+			p.Match(CQLParserNumericLiteral)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
 	{
-		p.SetState(303)
+		p.SetState(495)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6598,12 +9560,12 @@ func (s *CoordListContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) CoordList() (localctx ICoordListContext) {
 	localctx = NewCoordListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, CQLParserRULE_coordList)
+	p.EnterRule(localctx, 82, CQLParserRULE_coordList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(305)
+		p.SetState(497)
 		p.Match(CQLParserLEFTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6611,10 +9573,10 @@ func (p *CQLParser) CoordList() (localctx ICoordListContext) {
 		}
 	}
 	{
-		p.SetState(306)
+		p.SetState(498)
 		p.Coordinate()
 	}
-	p.SetState(311)
+	p.SetState(503)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6623,7 +9585,7 @@ func (p *CQLParser) CoordList() (localctx ICoordListContext) {
 
 	for _la == CQLParserCOMMA {
 		{
-			p.SetState(307)
+			p.SetState(499)
 			p.Match(CQLParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6631,11 +9593,11 @@ func (p *CQLParser) CoordList() (localctx ICoordListContext) {
 			}
 		}
 		{
-			p.SetState(308)
+			p.SetState(500)
 			p.Coordinate()
 		}
 
-		p.SetState(313)
+		p.SetState(505)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -6643,7 +9605,7 @@ func (p *CQLParser) CoordList() (localctx ICoordListContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(314)
+		p.SetState(506)
 		p.Match(CQLParserRIGHTPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6740,10 +9702,12 @@ func (s *CoordinateContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *CQLParser) Coordinate() (localctx ICoordinateContext) {
 	localctx = NewCoordinateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, CQLParserRULE_coordinate)
+	p.EnterRule(localctx, 84, CQLParserRULE_coordinate)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(316)
+		p.SetState(508)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6751,13 +9715,144 @@ func (p *CQLParser) Coordinate() (localctx ICoordinateContext) {
 		}
 	}
 	{
-		p.SetState(317)
+		p.SetState(509)
 		p.Match(CQLParserNumericLiteral)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(514)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserNumericLiteral {
+		{
+			p.SetState(510)
+			p.Match(CQLParserNumericLiteral)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(512)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CQLParserNumericLiteral {
+			{
+				p.SetState(511)
+				p.Match(CQLParserNumericLiteral)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDimensionContext is an interface to support dynamic dispatch.
+type IDimensionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() antlr.TerminalNode
+
+	// IsDimensionContext differentiates from other interfaces.
+	IsDimensionContext()
+}
+
+type DimensionContext struct {
+	*CqlContext
+	parser antlr.Parser
+}
+
+func NewEmptyDimensionContext() *DimensionContext {
+	var p = new(DimensionContext)
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_dimension
+	return p
+}
+
+func InitEmptyDimensionContext(p *DimensionContext) {
+	p.CqlContext = NewCqlContext(nil, -1) // Jim super
+	p.RuleIndex = CQLParserRULE_dimension
+}
+
+func (*DimensionContext) IsDimensionContext() {}
+
+func NewDimensionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DimensionContext {
+	var p = new(DimensionContext)
+
+	p.CqlContext = NewCqlContext(parent, invokingState)
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_dimension
+
+	return p
+}
+
+func (s *DimensionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DimensionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(CQLParserIdentifier, 0)
+}
+
+func (s *DimensionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DimensionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DimensionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.EnterDimension(s)
+	}
+}
+
+func (s *DimensionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLParserListener); ok {
+		listenerT.ExitDimension(s)
+	}
+}
+
+func (p *CQLParser) Dimension() (localctx IDimensionContext) {
+	localctx = NewDimensionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, CQLParserRULE_dimension)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(516)
+		p.Match(CQLParserIdentifier)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
 
 errorExit:
 	if p.HasError() {
@@ -6781,7 +9876,7 @@ func (p *CQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 		}
 		return p.BooleanExpression_Sempred(t, predIndex)
 
-	case 10:
+	case 11:
 		var t *ScalarExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ScalarExpressionContext)
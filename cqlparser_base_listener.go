@@ -62,6 +62,12 @@ func (s *BaseCQLParserListener) EnterBooleanTerm(ctx *BooleanTermContext) {}
 // ExitBooleanTerm is called when production booleanTerm is exited.
 func (s *BaseCQLParserListener) ExitBooleanTerm(ctx *BooleanTermContext) {}
 
+// EnterTruthValue is called when production truthValue is entered.
+func (s *BaseCQLParserListener) EnterTruthValue(ctx *TruthValueContext) {}
+
+// ExitTruthValue is called when production truthValue is exited.
+func (s *BaseCQLParserListener) ExitTruthValue(ctx *TruthValueContext) {}
+
 // EnterPredicate is called when production predicate is entered.
 func (s *BaseCQLParserListener) EnterPredicate(ctx *PredicateContext) {}
 
@@ -178,12 +184,24 @@ func (s *BaseCQLParserListener) EnterLiteralTemporal(ctx *LiteralTemporalContext
 // ExitLiteralTemporal is called when production LiteralTemporal is exited.
 func (s *BaseCQLParserListener) ExitLiteralTemporal(ctx *LiteralTemporalContext) {}
 
+// EnterFunctionValue is called when production FunctionValue is entered.
+func (s *BaseCQLParserListener) EnterFunctionValue(ctx *FunctionValueContext) {}
+
+// ExitFunctionValue is called when production FunctionValue is exited.
+func (s *BaseCQLParserListener) ExitFunctionValue(ctx *FunctionValueContext) {}
+
 // EnterPropertyName is called when production propertyName is entered.
 func (s *BaseCQLParserListener) EnterPropertyName(ctx *PropertyNameContext) {}
 
 // ExitPropertyName is called when production propertyName is exited.
 func (s *BaseCQLParserListener) ExitPropertyName(ctx *PropertyNameContext) {}
 
+// EnterFunction is called when production function is entered.
+func (s *BaseCQLParserListener) EnterFunction(ctx *FunctionContext) {}
+
+// ExitFunction is called when production function is exited.
+func (s *BaseCQLParserListener) ExitFunction(ctx *FunctionContext) {}
+
 // EnterCharacterLiteral is called when production characterLiteral is entered.
 func (s *BaseCQLParserListener) EnterCharacterLiteral(ctx *CharacterLiteralContext) {}
 
@@ -286,6 +304,54 @@ func (s *BaseCQLParserListener) EnterGeometryCollection(ctx *GeometryCollectionC
 // ExitGeometryCollection is called when production geometryCollection is exited.
 func (s *BaseCQLParserListener) ExitGeometryCollection(ctx *GeometryCollectionContext) {}
 
+// EnterCircularString is called when production circularString is entered.
+func (s *BaseCQLParserListener) EnterCircularString(ctx *CircularStringContext) {}
+
+// ExitCircularString is called when production circularString is exited.
+func (s *BaseCQLParserListener) ExitCircularString(ctx *CircularStringContext) {}
+
+// EnterCompoundCurve is called when production compoundCurve is entered.
+func (s *BaseCQLParserListener) EnterCompoundCurve(ctx *CompoundCurveContext) {}
+
+// ExitCompoundCurve is called when production compoundCurve is exited.
+func (s *BaseCQLParserListener) ExitCompoundCurve(ctx *CompoundCurveContext) {}
+
+// EnterCurveMember is called when production curveMember is entered.
+func (s *BaseCQLParserListener) EnterCurveMember(ctx *CurveMemberContext) {}
+
+// ExitCurveMember is called when production curveMember is exited.
+func (s *BaseCQLParserListener) ExitCurveMember(ctx *CurveMemberContext) {}
+
+// EnterCurvePolygon is called when production curvePolygon is entered.
+func (s *BaseCQLParserListener) EnterCurvePolygon(ctx *CurvePolygonContext) {}
+
+// ExitCurvePolygon is called when production curvePolygon is exited.
+func (s *BaseCQLParserListener) ExitCurvePolygon(ctx *CurvePolygonContext) {}
+
+// EnterCurveRing is called when production curveRing is entered.
+func (s *BaseCQLParserListener) EnterCurveRing(ctx *CurveRingContext) {}
+
+// ExitCurveRing is called when production curveRing is exited.
+func (s *BaseCQLParserListener) ExitCurveRing(ctx *CurveRingContext) {}
+
+// EnterMultiCurve is called when production multiCurve is entered.
+func (s *BaseCQLParserListener) EnterMultiCurve(ctx *MultiCurveContext) {}
+
+// ExitMultiCurve is called when production multiCurve is exited.
+func (s *BaseCQLParserListener) ExitMultiCurve(ctx *MultiCurveContext) {}
+
+// EnterMultiSurface is called when production multiSurface is entered.
+func (s *BaseCQLParserListener) EnterMultiSurface(ctx *MultiSurfaceContext) {}
+
+// ExitMultiSurface is called when production multiSurface is exited.
+func (s *BaseCQLParserListener) ExitMultiSurface(ctx *MultiSurfaceContext) {}
+
+// EnterSurfaceMember is called when production surfaceMember is entered.
+func (s *BaseCQLParserListener) EnterSurfaceMember(ctx *SurfaceMemberContext) {}
+
+// ExitSurfaceMember is called when production surfaceMember is exited.
+func (s *BaseCQLParserListener) ExitSurfaceMember(ctx *SurfaceMemberContext) {}
+
 // EnterEnvelope is called when production envelope is entered.
 func (s *BaseCQLParserListener) EnterEnvelope(ctx *EnvelopeContext) {}
 
@@ -303,3 +369,9 @@ func (s *BaseCQLParserListener) EnterCoordinate(ctx *CoordinateContext) {}
 
 // ExitCoordinate is called when production coordinate is exited.
 func (s *BaseCQLParserListener) ExitCoordinate(ctx *CoordinateContext) {}
+
+// EnterDimension is called when production dimension is entered.
+func (s *BaseCQLParserListener) EnterDimension(ctx *DimensionContext) {}
+
+// ExitDimension is called when production dimension is exited.
+func (s *BaseCQLParserListener) ExitDimension(ctx *DimensionContext) {}
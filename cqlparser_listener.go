@@ -28,6 +28,9 @@ type CQLParserListener interface {
 	// EnterBooleanTerm is called when entering the booleanTerm production.
 	EnterBooleanTerm(c *BooleanTermContext)
 
+	// EnterTruthValue is called when entering the truthValue production.
+	EnterTruthValue(c *TruthValueContext)
+
 	// EnterPredicate is called when entering the predicate production.
 	EnterPredicate(c *PredicateContext)
 
@@ -85,9 +88,15 @@ type CQLParserListener interface {
 	// EnterLiteralTemporal is called when entering the LiteralTemporal production.
 	EnterLiteralTemporal(c *LiteralTemporalContext)
 
+	// EnterFunctionValue is called when entering the FunctionValue production.
+	EnterFunctionValue(c *FunctionValueContext)
+
 	// EnterPropertyName is called when entering the propertyName production.
 	EnterPropertyName(c *PropertyNameContext)
 
+	// EnterFunction is called when entering the function production.
+	EnterFunction(c *FunctionContext)
+
 	// EnterCharacterLiteral is called when entering the characterLiteral production.
 	EnterCharacterLiteral(c *CharacterLiteralContext)
 
@@ -139,6 +148,30 @@ type CQLParserListener interface {
 	// EnterGeometryCollection is called when entering the geometryCollection production.
 	EnterGeometryCollection(c *GeometryCollectionContext)
 
+	// EnterCircularString is called when entering the circularString production.
+	EnterCircularString(c *CircularStringContext)
+
+	// EnterCompoundCurve is called when entering the compoundCurve production.
+	EnterCompoundCurve(c *CompoundCurveContext)
+
+	// EnterCurveMember is called when entering the curveMember production.
+	EnterCurveMember(c *CurveMemberContext)
+
+	// EnterCurvePolygon is called when entering the curvePolygon production.
+	EnterCurvePolygon(c *CurvePolygonContext)
+
+	// EnterCurveRing is called when entering the curveRing production.
+	EnterCurveRing(c *CurveRingContext)
+
+	// EnterMultiCurve is called when entering the multiCurve production.
+	EnterMultiCurve(c *MultiCurveContext)
+
+	// EnterMultiSurface is called when entering the multiSurface production.
+	EnterMultiSurface(c *MultiSurfaceContext)
+
+	// EnterSurfaceMember is called when entering the surfaceMember production.
+	EnterSurfaceMember(c *SurfaceMemberContext)
+
 	// EnterEnvelope is called when entering the envelope production.
 	EnterEnvelope(c *EnvelopeContext)
 
@@ -148,6 +181,9 @@ type CQLParserListener interface {
 	// EnterCoordinate is called when entering the coordinate production.
 	EnterCoordinate(c *CoordinateContext)
 
+	// EnterDimension is called when entering the dimension production.
+	EnterDimension(c *DimensionContext)
+
 	// ExitCqlFilter is called when exiting the cqlFilter production.
 	ExitCqlFilter(c *CqlFilterContext)
 
@@ -169,6 +205,9 @@ type CQLParserListener interface {
 	// ExitBooleanTerm is called when exiting the booleanTerm production.
 	ExitBooleanTerm(c *BooleanTermContext)
 
+	// ExitTruthValue is called when exiting the truthValue production.
+	ExitTruthValue(c *TruthValueContext)
+
 	// ExitPredicate is called when exiting the predicate production.
 	ExitPredicate(c *PredicateContext)
 
@@ -226,9 +265,15 @@ type CQLParserListener interface {
 	// ExitLiteralTemporal is called when exiting the LiteralTemporal production.
 	ExitLiteralTemporal(c *LiteralTemporalContext)
 
+	// ExitFunctionValue is called when exiting the FunctionValue production.
+	ExitFunctionValue(c *FunctionValueContext)
+
 	// ExitPropertyName is called when exiting the propertyName production.
 	ExitPropertyName(c *PropertyNameContext)
 
+	// ExitFunction is called when exiting the function production.
+	ExitFunction(c *FunctionContext)
+
 	// ExitCharacterLiteral is called when exiting the characterLiteral production.
 	ExitCharacterLiteral(c *CharacterLiteralContext)
 
@@ -280,6 +325,30 @@ type CQLParserListener interface {
 	// ExitGeometryCollection is called when exiting the geometryCollection production.
 	ExitGeometryCollection(c *GeometryCollectionContext)
 
+	// ExitCircularString is called when exiting the circularString production.
+	ExitCircularString(c *CircularStringContext)
+
+	// ExitCompoundCurve is called when exiting the compoundCurve production.
+	ExitCompoundCurve(c *CompoundCurveContext)
+
+	// ExitCurveMember is called when exiting the curveMember production.
+	ExitCurveMember(c *CurveMemberContext)
+
+	// ExitCurvePolygon is called when exiting the curvePolygon production.
+	ExitCurvePolygon(c *CurvePolygonContext)
+
+	// ExitCurveRing is called when exiting the curveRing production.
+	ExitCurveRing(c *CurveRingContext)
+
+	// ExitMultiCurve is called when exiting the multiCurve production.
+	ExitMultiCurve(c *MultiCurveContext)
+
+	// ExitMultiSurface is called when exiting the multiSurface production.
+	ExitMultiSurface(c *MultiSurfaceContext)
+
+	// ExitSurfaceMember is called when exiting the surfaceMember production.
+	ExitSurfaceMember(c *SurfaceMemberContext)
+
 	// ExitEnvelope is called when exiting the envelope production.
 	ExitEnvelope(c *EnvelopeContext)
 
@@ -288,4 +357,7 @@ type CQLParserListener interface {
 
 	// ExitCoordinate is called when exiting the coordinate production.
 	ExitCoordinate(c *CoordinateContext)
+
+	// ExitDimension is called when exiting the dimension production.
+	ExitDimension(c *DimensionContext)
 }
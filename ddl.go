@@ -0,0 +1,706 @@
+package cql2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QueryablesFromDDL derives the queryables of tables and views from
+// CREATE TABLE and CREATE VIEW statements, without a database connection.
+// The result is keyed by the table or view name as written in the DDL
+// (including any schema qualifier), folded to lower case unless quoted.
+// Columns with a type which cannot be filtered (such as arrays or JSON) are omitted.
+// The columns of a view are typed by explicit casts in its select list,
+// or by the column of a table defined earlier which they refer to.
+// Other statements are ignored.
+func QueryablesFromDDL(ddl string) (map[string]Queryables, error) {
+	toks, err := tokenizeSQL(ddl)
+	if err != nil {
+		return nil, err
+	}
+	p := &ddlParser{
+		tables:  make(map[string]Queryables),
+		columns: make(map[string][]string),
+	}
+	start := 0
+	for i, tok := range toks {
+		if tok.isSymbol(";") {
+			p.parseStatement(toks[start:i])
+			start = i + 1
+		}
+	}
+	p.parseStatement(toks[start:])
+	return p.tables, nil
+}
+
+// columnInfo is a row of information_schema.columns,
+// optionally joined with geometry_columns for the geometry type and SRID
+type columnInfo struct {
+	TableSchema  string `json:"table_schema"`
+	TableName    string `json:"table_name"`
+	ColumnName   string `json:"column_name"`
+	DataType     string `json:"data_type"`
+	UdtName      string `json:"udt_name"`
+	GeometryType string `json:"type"`
+	SRID         int    `json:"srid"`
+}
+
+// QueryablesFromColumns derives the queryables of tables from a JSON array of
+// information_schema.columns rows (with at least table_name, column_name and data_type).
+// The geometry type and SRID of geometry columns are taken from the "type" and "srid" fields
+// of rows joined with geometry_columns, or from a data_type such as geometry(Polygon,4326).
+// The result is keyed by table name, qualified by the schema if table_schema is present.
+func QueryablesFromColumns(dump []byte) (map[string]Queryables, error) {
+	var rows []columnInfo
+	if err := json.Unmarshal(dump, &rows); err != nil {
+		return nil, fmt.Errorf("invalid columns dump: %v", err)
+	}
+	tables := make(map[string]Queryables)
+	for _, row := range rows {
+		if row.TableName == "" || row.ColumnName == "" {
+			return nil, fmt.Errorf("invalid columns dump: row without table_name or column_name")
+		}
+		dataType := row.DataType
+		if strings.EqualFold(dataType, "USER-DEFINED") {
+			dataType = row.UdtName
+		}
+		if row.GeometryType != "" {
+			dataType = fmt.Sprintf("%s(%s,%d)", dataType, row.GeometryType, row.SRID)
+		}
+		toks, err := tokenizeSQL(dataType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type of column %s: %v", row.ColumnName, err)
+		}
+		q, ok := queryableForType(toks)
+		if !ok {
+			continue
+		}
+		q.Column = row.ColumnName
+		table := row.TableName
+		if row.TableSchema != "" {
+			table = row.TableSchema + "." + table
+		}
+		if tables[table] == nil {
+			tables[table] = make(Queryables)
+		}
+		tables[table][row.ColumnName] = q
+	}
+	return tables, nil
+}
+
+const (
+	sqlIdent = iota
+	sqlQuotedIdent
+	sqlNumber
+	sqlString
+	sqlSymbol
+)
+
+type sqlToken struct {
+	kind int
+	// identifiers are folded to lower case unless quoted
+	text string
+}
+
+func (t sqlToken) isSymbol(s string) bool {
+	return t.kind == sqlSymbol && t.text == s
+}
+
+func (t sqlToken) isKeyword(kw ...string) bool {
+	if t.kind != sqlIdent {
+		return false
+	}
+	for _, k := range kw {
+		if t.text == k {
+			return true
+		}
+	}
+	return false
+}
+
+func (t sqlToken) isName() bool {
+	return t.kind == sqlIdent || t.kind == sqlQuotedIdent
+}
+
+// tokenizeSQL splits SQL text into tokens, skipping whitespace and comments
+func tokenizeSQL(sql string) ([]sqlToken, error) {
+	var toks []sqlToken
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case strings.HasPrefix(sql[i:], "--"):
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			i += end
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += end + 4
+		case c == '$' && dollarTag(sql[i:]) != "":
+			//-- dollar-quoted string, e.g. a function body
+			tag := dollarTag(sql[i:])
+			end := strings.Index(sql[i+len(tag):], tag)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quoted text at offset %d", i)
+			}
+			toks = append(toks, sqlToken{sqlString, sql[i+len(tag) : i+len(tag)+end]})
+			i += end + 2*len(tag)
+		case c == '\'' || c == '"':
+			text, n, ok := quotedSQL(sql[i:], c)
+			if !ok {
+				return nil, fmt.Errorf("unterminated quoted text at offset %d", i)
+			}
+			kind := sqlString
+			if c == '"' {
+				kind = sqlQuotedIdent
+			}
+			toks = append(toks, sqlToken{kind, text})
+			i += n
+		case isSQLIdentChar(c) && !isDigit(c):
+			j := i
+			for j < len(sql) && isSQLIdentChar(sql[j]) {
+				j++
+			}
+			toks = append(toks, sqlToken{sqlIdent, strings.ToLower(sql[i:j])})
+			i = j
+		case isDigit(c):
+			j := i
+			for j < len(sql) && (isDigit(sql[j]) || sql[j] == '.') {
+				j++
+			}
+			toks = append(toks, sqlToken{sqlNumber, sql[i:j]})
+			i = j
+		case strings.HasPrefix(sql[i:], "::"):
+			toks = append(toks, sqlToken{sqlSymbol, "::"})
+			i += 2
+		default:
+			toks = append(toks, sqlToken{sqlSymbol, string(c)})
+			i++
+		}
+	}
+	return toks, nil
+}
+
+// quotedSQL returns the text of a quoted string or identifier, and its length in the SQL
+func quotedSQL(sql string, quote byte) (string, int, bool) {
+	var sb strings.Builder
+	for i := 1; i < len(sql); i++ {
+		if sql[i] != quote {
+			sb.WriteByte(sql[i])
+		} else if i+1 < len(sql) && sql[i+1] == quote {
+			//-- doubled quote
+			sb.WriteByte(quote)
+			i++
+		} else {
+			return sb.String(), i + 1, true
+		}
+	}
+	return "", 0, false
+}
+
+// dollarTag returns the opening tag of a dollar-quoted string ($$ or $tag$), if any
+func dollarTag(sql string) string {
+	for i := 1; i < len(sql); i++ {
+		if sql[i] == '$' {
+			return sql[:i+1]
+		}
+		if !isSQLIdentChar(sql[i]) || isDigit(sql[i]) {
+			return ""
+		}
+	}
+	return ""
+}
+
+func isSQLIdentChar(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+type ddlParser struct {
+	tables map[string]Queryables
+	// column names of tables and views in order, for expanding SELECT *
+	columns map[string][]string
+}
+
+func (p *ddlParser) parseStatement(toks []sqlToken) {
+	if len(toks) == 0 || !toks[0].isKeyword("create") {
+		return
+	}
+	i := 1
+	for i < len(toks) && toks[i].isKeyword("or", "replace", "temp", "temporary", "unlogged",
+		"global", "local", "materialized", "recursive") {
+		i++
+	}
+	if i >= len(toks) || !toks[i].isKeyword("table", "view") {
+		return
+	}
+	isView := toks[i].isKeyword("view")
+	i++
+	if i+2 < len(toks) && toks[i].isKeyword("if") && toks[i+1].isKeyword("not") && toks[i+2].isKeyword("exists") {
+		i += 3
+	}
+	name, i := qualifiedName(toks, i)
+	if name == "" {
+		return
+	}
+	var columnNames []string
+	if isView && i < len(toks) && toks[i].isSymbol("(") {
+		group, end := parenGroup(toks, i)
+		for _, elem := range splitTopLevel(group) {
+			if len(elem) > 0 {
+				columnNames = append(columnNames, elem[0].text)
+			}
+		}
+		i = end
+	}
+	if !isView && i < len(toks) && toks[i].isSymbol("(") {
+		group, _ := parenGroup(toks, i)
+		p.parseTableElements(name, group)
+		return
+	}
+	//-- a view, or a table created from a query
+	for i < len(toks) && !toks[i].isKeyword("select") {
+		i++
+	}
+	if i < len(toks) {
+		p.parseSelect(name, columnNames, toks[i+1:])
+	}
+}
+
+// resolveTable finds a table referred to in a query, which may omit the schema
+func (p *ddlParser) resolveTable(name string) string {
+	if _, ok := p.columns[name]; ok {
+		return name
+	}
+	for table := range p.columns {
+		if strings.HasSuffix(table, "."+name) {
+			return table
+		}
+	}
+	return name
+}
+
+func (p *ddlParser) addColumn(table string, column string, q Queryable, ok bool) {
+	p.columns[table] = append(p.columns[table], column)
+	if !ok {
+		return
+	}
+	if p.tables[table] == nil {
+		p.tables[table] = make(Queryables)
+	}
+	q.Column = column
+	p.tables[table][column] = q
+}
+
+func (p *ddlParser) parseTableElements(table string, toks []sqlToken) {
+	for _, elem := range splitTopLevel(toks) {
+		if len(elem) < 2 || !elem[0].isName() ||
+			elem[0].isKeyword("constraint", "primary", "unique", "check", "foreign", "exclude", "like") {
+			continue
+		}
+		end := 1
+		for end < len(elem) && !elem[end].isKeyword("not", "null", "default", "primary", "unique", "check",
+			"references", "constraint", "generated", "collate") {
+			end++
+		}
+		q, ok := queryableForType(elem[1:end])
+		p.addColumn(table, elem[0].text, q, ok)
+	}
+}
+
+func (p *ddlParser) parseSelect(view string, columnNames []string, toks []sqlToken) {
+	//-- the select list ends at FROM
+	end := len(toks)
+	depth := 0
+	for i, tok := range toks {
+		if tok.isSymbol("(") {
+			depth++
+		} else if tok.isSymbol(")") {
+			depth--
+		} else if depth == 0 && tok.isKeyword("from") {
+			end = i
+			break
+		}
+	}
+	aliases := fromTables(toks[end:])
+	for i, table := range aliases.tables {
+		aliases.tables[i] = p.resolveTable(table)
+	}
+	for alias, table := range aliases.byAlias {
+		aliases.byAlias[alias] = p.resolveTable(table)
+	}
+	list := toks[:end]
+	if len(list) > 0 && list[0].isKeyword("all") {
+		list = list[1:]
+	} else if len(list) > 0 && list[0].isKeyword("distinct") {
+		list = list[1:]
+		if len(list) > 1 && list[0].isKeyword("on") {
+			_, next := parenGroup(list, 1)
+			list = list[next:]
+		}
+	}
+	n := 0
+	for _, item := range splitTopLevel(list) {
+		if len(item) == 0 {
+			continue
+		}
+		//-- * and t.* expand to the columns of the tables
+		if item[len(item)-1].isSymbol("*") {
+			tables := aliases.tables
+			if len(item) == 3 {
+				tables = []string{aliases.byAlias[item[0].text]}
+			}
+			for _, table := range tables {
+				for _, col := range p.columns[table] {
+					q, ok := p.tables[table][col]
+					p.addColumn(view, viewColumnName(columnNames, n, col), q, ok)
+					n++
+				}
+			}
+			continue
+		}
+		name, expr := selectItem(item)
+		q, ok := p.itemQueryable(expr, aliases)
+		p.addColumn(view, viewColumnName(columnNames, n, name), q, ok)
+		n++
+	}
+}
+
+func viewColumnName(columnNames []string, n int, name string) string {
+	if n < len(columnNames) {
+		return columnNames[n]
+	}
+	return name
+}
+
+// selectItem returns the output column name and the expression of a select list item
+func selectItem(item []sqlToken) (string, []sqlToken) {
+	n := len(item)
+	if n >= 3 && item[n-2].isKeyword("as") && item[n-1].isName() {
+		return item[n-1].text, item[:n-2]
+	}
+	if n >= 2 && item[n-1].isName() && hasAlias(item) {
+		return item[n-1].text, item[:n-1]
+	}
+	//-- an unnamed column is named by the column it refers to
+	expr := item
+	if k := castIndex(item); k > 0 {
+		expr = item[:k]
+	}
+	last := expr[len(expr)-1]
+	if last.isName() && (len(expr) == 1 || len(expr) == 3 && expr[1].isSymbol(".")) {
+		return last.text, item
+	}
+	return "?column?", item
+}
+
+// hasAlias reports whether a select list item ending with a name is an expression followed by an alias without AS
+func hasAlias(item []sqlToken) bool {
+	n := len(item)
+	if item[n-2].isSymbol(".") || item[n-2].isSymbol("::") {
+		return false
+	}
+	//-- the name may be the last word of a cast type, e.g. x::double precision
+	if k := castIndex(item); k > 0 {
+		_, typeOk := queryableForType(item[k+1:])
+		_, prefixOk := queryableForType(item[k+1 : n-1])
+		return !typeOk && prefixOk
+	}
+	return true
+}
+
+// castIndex returns the index of the first cast operator outside of parentheses, or -1
+func castIndex(toks []sqlToken) int {
+	depth := 0
+	for i, tok := range toks {
+		if tok.isSymbol("(") {
+			depth++
+		} else if tok.isSymbol(")") {
+			depth--
+		} else if depth == 0 && tok.isSymbol("::") {
+			return i
+		}
+	}
+	return -1
+}
+
+// itemQueryable types a select list expression from a cast or the column it refers to
+func (p *ddlParser) itemQueryable(expr []sqlToken, aliases fromClause) (Queryable, bool) {
+	//-- expr::type
+	depth := 0
+	for i, tok := range expr {
+		if tok.isSymbol("(") {
+			depth++
+		} else if tok.isSymbol(")") {
+			depth--
+		} else if depth == 0 && tok.isSymbol("::") {
+			if q, ok := queryableForType(expr[i+1:]); ok || !containsSymbol(expr[i+1:], "::") {
+				return q, ok
+			}
+		}
+	}
+	//-- CAST(expr AS type)
+	if len(expr) > 2 && expr[0].isKeyword("cast") && expr[1].isSymbol("(") {
+		group, end := parenGroup(expr, 1)
+		if end == len(expr) {
+			for i := len(group) - 1; i >= 0; i-- {
+				if group[i].isKeyword("as") {
+					return queryableForType(group[i+1:])
+				}
+			}
+		}
+	}
+	//-- column or table.column
+	var column string
+	tables := aliases.tables
+	switch {
+	case len(expr) == 1 && expr[0].isName():
+		column = expr[0].text
+	case len(expr) == 3 && expr[0].isName() && expr[1].isSymbol(".") && expr[2].isName():
+		column = expr[2].text
+		tables = []string{aliases.byAlias[expr[0].text]}
+	default:
+		return Queryable{}, false
+	}
+	for _, table := range tables {
+		if q, ok := p.tables[table][column]; ok {
+			return q, true
+		}
+	}
+	return Queryable{}, false
+}
+
+func containsSymbol(toks []sqlToken, s string) bool {
+	for _, tok := range toks {
+		if tok.isSymbol(s) {
+			return true
+		}
+	}
+	return false
+}
+
+type fromClause struct {
+	// tables in order of appearance
+	tables []string
+	// tables by alias (and by name)
+	byAlias map[string]string
+}
+
+// fromTables collects the tables of a FROM clause and their aliases
+func fromTables(toks []sqlToken) fromClause {
+	from := fromClause{byAlias: make(map[string]string)}
+	depth := 0
+	for i := 0; i < len(toks); i++ {
+		if toks[i].isSymbol("(") {
+			depth++
+		} else if toks[i].isSymbol(")") {
+			depth--
+		}
+		if depth > 0 {
+			continue
+		}
+		if toks[i].isKeyword("where", "group", "having", "window", "order", "limit", "union", "intersect", "except") {
+			break
+		}
+		if !toks[i].isKeyword("from", "join") && !toks[i].isSymbol(",") {
+			continue
+		}
+		name, next := qualifiedName(toks, i+1)
+		if name == "" {
+			continue
+		}
+		from.tables = append(from.tables, name)
+		from.byAlias[name] = name
+		if dot := strings.LastIndex(name, "."); dot >= 0 {
+			from.byAlias[name[dot+1:]] = name
+		}
+		if next < len(toks) && toks[next].isKeyword("as") {
+			next++
+		}
+		if next < len(toks) && toks[next].isName() && !toks[next].isKeyword("join", "inner", "left", "right",
+			"full", "cross", "natural", "on", "using", "where", "group", "order", "limit", "union", "having", "window") {
+			from.byAlias[toks[next].text] = name
+		}
+		i = next - 1
+	}
+	return from
+}
+
+// qualifiedName reads a possibly schema-qualified name starting at toks[i]
+func qualifiedName(toks []sqlToken, i int) (string, int) {
+	var parts []string
+	for i < len(toks) && toks[i].isName() {
+		parts = append(parts, toks[i].text)
+		i++
+		if i+1 < len(toks) && toks[i].isSymbol(".") {
+			i++
+		} else {
+			break
+		}
+	}
+	return strings.Join(parts, "."), i
+}
+
+// parenGroup returns the tokens inside the parentheses starting at toks[i],
+// and the index after the closing parenthesis
+func parenGroup(toks []sqlToken, i int) ([]sqlToken, int) {
+	depth := 0
+	for j := i; j < len(toks); j++ {
+		if toks[j].isSymbol("(") {
+			depth++
+		} else if toks[j].isSymbol(")") {
+			depth--
+			if depth == 0 {
+				return toks[i+1 : j], j + 1
+			}
+		}
+	}
+	return toks[i+1:], len(toks)
+}
+
+// splitTopLevel splits tokens at commas outside of parentheses
+func splitTopLevel(toks []sqlToken) [][]sqlToken {
+	var parts [][]sqlToken
+	depth := 0
+	start := 0
+	for i, tok := range toks {
+		if tok.isSymbol("(") || tok.isSymbol("[") {
+			depth++
+		} else if tok.isSymbol(")") || tok.isSymbol("]") {
+			depth--
+		} else if depth == 0 && tok.isSymbol(",") {
+			parts = append(parts, toks[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, toks[start:])
+}
+
+// Postgres types by name, as queryable type and format
+var queryableForSQLType = map[string]Queryable{
+	"smallint":          {Type: "integer"},
+	"integer":           {Type: "integer"},
+	"int":               {Type: "integer"},
+	"int2":              {Type: "integer"},
+	"int4":              {Type: "integer"},
+	"int8":              {Type: "integer"},
+	"bigint":            {Type: "integer"},
+	"smallserial":       {Type: "integer"},
+	"serial":            {Type: "integer"},
+	"serial2":           {Type: "integer"},
+	"serial4":           {Type: "integer"},
+	"serial8":           {Type: "integer"},
+	"bigserial":         {Type: "integer"},
+	"real":              {Type: "number"},
+	"float":             {Type: "number"},
+	"float4":            {Type: "number"},
+	"float8":            {Type: "number"},
+	"double precision":  {Type: "number"},
+	"numeric":           {Type: "number"},
+	"decimal":           {Type: "number"},
+	"boolean":           {Type: "boolean"},
+	"bool":              {Type: "boolean"},
+	"text":              {Type: "string"},
+	"varchar":           {Type: "string"},
+	"character varying": {Type: "string"},
+	"character":         {Type: "string"},
+	"char":              {Type: "string"},
+	"bpchar":            {Type: "string"},
+	"citext":            {Type: "string"},
+	"name":              {Type: "string"},
+	"uuid":              {Type: "string"},
+	"date":              {Type: "string", Format: "date"},
+	"timestamp":         {Type: "string", Format: "date-time"},
+	"timestamptz":       {Type: "string", Format: "date-time"},
+	"time":              {Type: "string"},
+	"timetz":            {Type: "string"},
+}
+
+// queryable formats of PostGIS geometry types
+var formatForGeometryType = map[string]string{
+	"point":              "geometry-point",
+	"linestring":         "geometry-linestring",
+	"polygon":            "geometry-polygon",
+	"multipoint":         "geometry-multipoint",
+	"multilinestring":    "geometry-multilinestring",
+	"multipolygon":       "geometry-multipolygon",
+	"geometrycollection": "geometry-geometrycollection",
+}
+
+// queryableForType maps a Postgres column type to a queryable.
+// It returns false if the type cannot be used in a filter.
+func queryableForType(toks []sqlToken) (Queryable, bool) {
+	//-- drop a schema qualifier, e.g. public.geometry
+	if len(toks) > 2 && toks[1].isSymbol(".") {
+		toks = toks[2:]
+	}
+	var words []string
+	i := 0
+	for i < len(toks) && toks[i].kind == sqlIdent {
+		words = append(words, toks[i].text)
+		i++
+	}
+	var args [][]sqlToken
+	if i < len(toks) && toks[i].isSymbol("(") {
+		var group []sqlToken
+		group, i = parenGroup(toks, i)
+		args = splitTopLevel(group)
+		//-- e.g. timestamp(3) with time zone
+		for i < len(toks) && toks[i].kind == sqlIdent {
+			words = append(words, toks[i].text)
+			i++
+		}
+	}
+	//-- arrays and unknown trailing syntax
+	if len(words) == 0 || i < len(toks) {
+		return Queryable{}, false
+	}
+	typeName := strings.Join(words, " ")
+	switch {
+	case words[0] == "geometry" || words[0] == "geography":
+		return geometryQueryable(words[0], args), len(words) == 1
+	case words[0] == "timestamp":
+		return queryableForSQLType["timestamp"], true
+	case words[0] == "time":
+		return queryableForSQLType["time"], true
+	}
+	q, ok := queryableForSQLType[typeName]
+	return q, ok
+}
+
+func geometryQueryable(typeName string, args [][]sqlToken) Queryable {
+	q := Queryable{Format: "geometry-any"}
+	if typeName == "geography" {
+		q.SRID = 4326
+		q.Geography = true
+	}
+	if len(args) > 0 && len(args[0]) == 1 {
+		geomType := args[0][0].text
+		for _, suffix := range []string{"zm", "z", "m"} {
+			if _, ok := formatForGeometryType[strings.TrimSuffix(geomType, suffix)]; ok {
+				geomType = strings.TrimSuffix(geomType, suffix)
+				break
+			}
+		}
+		if format, ok := formatForGeometryType[strings.ToLower(geomType)]; ok {
+			q.Format = format
+		}
+	}
+	if len(args) > 1 && len(args[1]) == 1 {
+		if srid, err := strconv.Atoi(args[1][0].text); err == nil && srid > 0 {
+			q.SRID = srid
+		}
+	}
+	return q
+}
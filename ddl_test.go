@@ -0,0 +1,148 @@
+package cql2_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+const placesDDL = `
+-- places of interest
+CREATE TABLE IF NOT EXISTS public.places (
+	id serial PRIMARY KEY,
+	"Name" character varying(100) NOT NULL,
+	pop_est bigint DEFAULT 0,
+	area double precision,
+	capital boolean,
+	founded date,
+	updated timestamp(3) with time zone DEFAULT now(),
+	tags text[],
+	props jsonb,
+	geom public.geometry(MultiPolygonZ, 3857),
+	centroid geography(Point),
+	CONSTRAINT pop_positive CHECK (pop_est >= 0)
+);
+
+CREATE INDEX places_geom_idx ON public.places USING gist (geom);
+
+CREATE FUNCTION touch() RETURNS trigger AS $$
+BEGIN NEW.updated := now(); RETURN NEW; END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE VIEW big_places AS
+	SELECT p.id, p."Name" AS name, p.pop_est::numeric pop, geom, CAST(updated AS date) AS day, upper(p."Name")
+	FROM places p
+	WHERE p.pop_est > 1000000;
+
+CREATE MATERIALIZED VIEW place_list (ident, label) AS SELECT DISTINCT * FROM big_places;
+`
+
+var _ = Describe("Queryables from DDL", func() {
+	tables, err := cql2.QueryablesFromDDL(placesDDL)
+
+	It("parses the DDL", func() {
+		Expect(err).To(BeNil())
+		Expect(tables).To(HaveLen(3))
+	})
+
+	It("maps table columns", func() {
+		Expect(tables["public.places"]).To(Equal(cql2.Queryables{
+			"id":       {Type: "integer", Column: "id"},
+			"Name":     {Type: "string", Column: "Name"},
+			"pop_est":  {Type: "integer", Column: "pop_est"},
+			"area":     {Type: "number", Column: "area"},
+			"capital":  {Type: "boolean", Column: "capital"},
+			"founded":  {Type: "string", Format: "date", Column: "founded"},
+			"updated":  {Type: "string", Format: "date-time", Column: "updated"},
+			"geom":     {Format: "geometry-multipolygon", SRID: 3857, Column: "geom"},
+			"centroid": {Format: "geometry-point", SRID: 4326, Column: "centroid", Geography: true},
+		}))
+	})
+
+	It("maps view columns", func() {
+		Expect(tables["big_places"]).To(Equal(cql2.Queryables{
+			"id":   {Type: "integer", Column: "id"},
+			"name": {Type: "string", Column: "name"},
+			"pop":  {Type: "number", Column: "pop"},
+			"geom": {Format: "geometry-multipolygon", SRID: 3857, Column: "geom"},
+			"day":  {Type: "string", Format: "date", Column: "day"},
+		}))
+		Expect(tables["place_list"]).To(HaveKeyWithValue("ident", cql2.Queryable{Type: "integer", Column: "ident"}))
+		Expect(tables["place_list"]).To(HaveKeyWithValue("label", cql2.Queryable{Type: "string", Column: "label"}))
+		Expect(tables["place_list"]).To(HaveKey("geom"))
+	})
+
+	It("reports unterminated text", func() {
+		_, err := cql2.QueryablesFromDDL("CREATE TABLE t (\"a int);")
+		Expect(err).ToNot(BeNil())
+	})
+
+	DescribeTable("translates with table queryables",
+		func(cqlStr string, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithQueryables(tables["public.places"]))
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("column name", "Name = 'Oslo' AND founded > '1000-01-01'", "\"Name\" = 'Oslo' AND \"founded\" > '1000-01-01'::date"),
+		Entry("geometry column SRID", "intersects(geom, POINT(10 60))",
+			"ST_Intersects(\"geom\",ST_Transform('SRID=4326;POINT(10 60)'::geometry,3857))"),
+		Entry("geography column SRID", "intersects(POINT(10 60), centroid)",
+			"ST_Intersects('SRID=4326;POINT(10 60)'::geography,\"centroid\")"),
+		Entry("geography column distance", "dwithin(centroid, POINT(10 60), 1000)",
+			"ST_DWithin(\"centroid\",'SRID=4326;POINT(10 60)'::geography,1000)"),
+		Entry("geography literal transformed", "intersects(centroid, SRID=3857;ENVELOPE(0,0,1,1))",
+			"ST_Intersects(\"centroid\",ST_Transform(ST_MakeEnvelope(0,0,1,1,3857),4326)::geography)"),
+		Entry("geography column without geography function", "within(centroid, POLYGON((0 0,1 0,1 1,0 0)))",
+			"ST_Within(\"centroid\"::geometry,'SRID=4326;POLYGON((0 0,1 0,1 1,0 0))'::geometry)"),
+	)
+
+	It("does not prefilter geography columns by box", func() {
+		actual, err := cql2.TranspileToSQL("intersects(centroid, POINT(10 60)) AND within(centroid, ENVELOPE(0,50,20,70))", 4326, 4326,
+			cql2.WithQueryables(tables["public.places"]), cql2.WithIndexPrefilter())
+		Expect(err).To(BeNil())
+		Expect(strings.TrimSpace(actual)).To(Equal("ST_Intersects(\"centroid\",'SRID=4326;POINT(10 60)'::geography) AND " +
+			"ST_Within(\"centroid\"::geometry,ST_MakeEnvelope(0,50,20,70,4326))"))
+	})
+})
+
+var _ = Describe("Queryables from columns", func() {
+	It("maps information_schema columns", func() {
+		tables, err := cql2.QueryablesFromColumns([]byte(`[
+			{"table_schema": "public", "table_name": "roads", "column_name": "id", "data_type": "integer", "udt_name": "int4"},
+			{"table_schema": "public", "table_name": "roads", "column_name": "Road Name", "data_type": "text", "udt_name": "text"},
+			{"table_schema": "public", "table_name": "roads", "column_name": "opened", "data_type": "timestamp without time zone", "udt_name": "timestamp"},
+			{"table_schema": "public", "table_name": "roads", "column_name": "lanes", "data_type": "ARRAY", "udt_name": "_int4"},
+			{"table_schema": "public", "table_name": "roads", "column_name": "geom", "data_type": "USER-DEFINED", "udt_name": "geometry", "type": "LINESTRING", "srid": 2193},
+			{"table_name": "stops", "column_name": "geom", "data_type": "geometry(Point,4326)"}
+		]`))
+		Expect(err).To(BeNil())
+		Expect(tables).To(Equal(map[string]cql2.Queryables{
+			"public.roads": {
+				"id":        {Type: "integer", Column: "id"},
+				"Road Name": {Type: "string", Column: "Road Name"},
+				"opened":    {Type: "string", Format: "date-time", Column: "opened"},
+				"geom":      {Format: "geometry-linestring", SRID: 2193, Column: "geom"},
+			},
+			"stops": {
+				"geom": {Format: "geometry-point", SRID: 4326, Column: "geom"},
+			},
+		}))
+	})
+
+	It("reports an invalid dump", func() {
+		_, err := cql2.QueryablesFromColumns([]byte(`{"table_name": "roads"}`))
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("maps property names to columns", func() {
+		actual, err := cql2.TranspileToSQL("label = 'x'", 4326, 4326, cql2.WithQueryables(cql2.Queryables{
+			"label": {Type: "string", Column: "Road \"Name\""},
+		}))
+		Expect(err).To(BeNil())
+		Expect(strings.TrimSpace(actual)).To(Equal("\"Road \"\"Name\"\"\" = 'x'"))
+	})
+})
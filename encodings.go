@@ -0,0 +1,139 @@
+package cql2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// EWKB flag indicating an embedded SRID
+const ewkbSRIDFlag = 0x20000000
+
+var geoJSONGeometryTypes = map[string]bool{
+	"Point":              true,
+	"LineString":         true,
+	"Polygon":            true,
+	"MultiPoint":         true,
+	"MultiLineString":    true,
+	"MultiPolygon":       true,
+	"GeometryCollection": true,
+}
+
+// sridOf returns the SRID of a geometry expression.
+// This is the filter SRID unless the literal specifies its own SRID.
+func (l *cqlListener) sridOf(ctx IGeomExpressionContext) int {
+	if prefix := ctx.EwktSridPrefix(); prefix != nil {
+		if srid := ewktSRID(prefix.GetText()); srid != 0 {
+			return srid
+		}
+	}
+	if wkb := wkbLiteral(ctx); wkb != nil {
+		if srid, ok, _ := ewkbSRID(wkb.GetText()); ok {
+			return srid
+		}
+	}
+	return l.filterSRID
+}
+
+// ewktSRID returns the SRID of an EWKT prefix SRID=<digits>;
+// or 0 if it is not valid
+func ewktSRID(prefix string) int {
+	digits := prefix[strings.Index(prefix, "=")+1 : len(prefix)-1]
+	srid, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0
+	}
+	return srid
+}
+
+func (l *cqlListener) EnterGeomExpression(ctx *GeomExpressionContext) {
+	l.geomSRID = l.sridOf(ctx)
+}
+
+// wkbLiteral returns the hex WKB of a geometry expression, if it is one
+func wkbLiteral(ctx IGeomExpressionContext) antlr.TerminalNode {
+	if wkb := ctx.WkbHexLiteral(); wkb != nil {
+		return wkb
+	}
+	return ctx.NumericLiteral()
+}
+
+func (l *cqlListener) sqlWkbLiteral(node antlr.TerminalNode) string {
+	text := node.GetText()
+	//-- the whole literal is decoded, as by Parse, so that PostGIS gets valid WKB
+	if _, err := decodeWKB(text); err != nil {
+		l.literalError(node, "invalid WKB: %v", err)
+		return ""
+	}
+	_, hasSRID, _ := ewkbSRID(text)
+	sql := fmt.Sprintf("'%s'::geometry", strings.ToUpper(text))
+	if !hasSRID {
+		sql = fmt.Sprintf("ST_SetSRID(%s,%d)", sql, l.geomSRID)
+	}
+	return l.sqlTransformCrs(sql)
+}
+
+func (l *cqlListener) sqlGeoJSONLiteral(node antlr.TerminalNode) string {
+	text := node.GetText()
+	var geom struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal([]byte(text), &geom); err != nil {
+		l.literalError(node, "invalid GeoJSON: %v", err)
+		return ""
+	}
+	if !geoJSONGeometryTypes[geom.Type] {
+		l.literalError(node, "invalid GeoJSON: %q is not a geometry type", geom.Type)
+		return ""
+	}
+	//-- compact the JSON to keep the statement small
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(text)); err != nil {
+		l.literalError(node, "invalid GeoJSON: %v", err)
+		return ""
+	}
+	geoJSON := strings.ReplaceAll(buf.String(), "'", "''")
+	sql := fmt.Sprintf("ST_SetSRID(ST_GeomFromGeoJSON('%s'),%d)", geoJSON, l.geomSRID)
+	return l.sqlTransformCrs(sql)
+}
+
+func (l *cqlListener) literalError(node antlr.TerminalNode, format string, args ...interface{}) {
+	tok := node.GetSymbol()
+	l.setError(&GeometryError{
+		Line:   tok.GetLine(),
+		Column: tok.GetColumn(),
+		Msg:    fmt.Sprintf(format, args...),
+	})
+}
+
+// ewkbSRID decodes the header of hex-encoded (E)WKB,
+// and returns the SRID if one is embedded.
+func ewkbSRID(hexText string) (int, bool, error) {
+	data, err := hex.DecodeString(hexText)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(data) < 9 {
+		return 0, false, fmt.Errorf("too short")
+	}
+	var order binary.ByteOrder
+	switch data[0] {
+	case 0:
+		order = binary.BigEndian
+	case 1:
+		order = binary.LittleEndian
+	default:
+		return 0, false, fmt.Errorf("invalid byte order %d", data[0])
+	}
+	geomType := order.Uint32(data[1:5])
+	if geomType&ewkbSRIDFlag == 0 {
+		return 0, false, nil
+	}
+	return int(order.Uint32(data[5:9])), true, nil
+}
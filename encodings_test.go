@@ -0,0 +1,58 @@
+package cql2_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("Geometry encodings", func() {
+	DescribeTable("literals",
+		func(cqlStr string, filterSRID int, sourceSRID int, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, filterSRID, sourceSRID)
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("EWKT", "intersects(geom, SRID=3857;POINT(1 2))", 4326, 3857,
+			"ST_Intersects(\"geom\",'SRID=3857;POINT(1 2)'::geometry)"),
+		Entry("EWKT transformed", "intersects(geom, srid=4269;POINT(1 2))", 4326, 3857,
+			"ST_Intersects(\"geom\",ST_Transform('SRID=4269;POINT(1 2)'::geometry,3857))"),
+		Entry("EWKT envelope", "intersects(geom, SRID=4269;ENVELOPE(170,-10,-170,10))", 3857, 4269,
+			"ST_Intersects(\"geom\",ST_Collect(ST_MakeEnvelope(170,-10,180,10,4269),ST_MakeEnvelope(-180,-10,-170,10,4269)))"),
+		Entry("WKB", "intersects(geom, 0101000000000000000000F03F0000000000000040)", 4326, 4326,
+			"ST_Intersects(\"geom\",ST_SetSRID('0101000000000000000000F03F0000000000000040'::geometry,4326))"),
+		Entry("WKB lower case", "intersects(geom, 0101000000000000000000f03f0000000000000040)", 4326, 4326,
+			"ST_Intersects(\"geom\",ST_SetSRID('0101000000000000000000F03F0000000000000040'::geometry,4326))"),
+		Entry("EWKB with SRID", "intersects(geom, 0101000020E6100000000000000000F03F0000000000000040)", 3857, 3857,
+			"ST_Intersects(\"geom\",ST_Transform('0101000020E6100000000000000000F03F0000000000000040'::geometry,3857))"),
+		Entry("GeoJSON", `intersects(geom, {"type": "Point", "coordinates": [1, 2]})`, 4326, 4326,
+			`ST_Intersects("geom",ST_SetSRID(ST_GeomFromGeoJSON('{"type":"Point","coordinates":[1,2]}'),4326))`),
+		Entry("GeoJSON polygon", `intersects(geom, {"type":"Polygon","coordinates":[[[0,0],[0,9],[9,0],[0,0]]]})`, 4326, 3857,
+			`ST_Intersects("geom",ST_Transform(ST_SetSRID(ST_GeomFromGeoJSON('{"type":"Polygon","coordinates":[[[0,0],[0,9],[9,0],[0,0]]]}'),4326),3857))`),
+		Entry("GeoJSON collection", `intersects(geom, {"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]}]})`, 4326, 4326,
+			`ST_Intersects("geom",ST_SetSRID(ST_GeomFromGeoJSON('{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]}]}'),4326))`),
+		Entry("numbers are not WKB", "x = 010000000000000000", 4326, 4326,
+			"\"x\" = 010000000000000000"),
+		Entry("big-endian WKB of digits", "intersects(geom, 000000000100000000000000000000000000000000)", 4326, 4326,
+			"ST_Intersects(\"geom\",ST_SetSRID('000000000100000000000000000000000000000000'::geometry,4326))"),
+	)
+
+	DescribeTable("invalid literals",
+		func(cqlStr string, column int) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326)
+			var geomErr *cql2.GeometryError
+			Expect(errors.As(err, &geomErr)).To(BeTrue())
+			Expect(geomErr.Column).To(Equal(column))
+		},
+		Entry("GeoJSON with invalid type", `intersects(geom, {"type": "Feature"})`, 17),
+		Entry("malformed GeoJSON", `intersects(geom, {"type": "Point",})`, 17),
+		Entry("truncated WKB", "intersects(geom, 0101000000000000000000F03F)", 17),
+		Entry("WKB with trailing bytes", "intersects(geom, 0101000000000000000000F03F000000000000004000)", 17),
+		Entry("number which is not WKB", "intersects(geom, 12)", 17),
+	)
+})
@@ -0,0 +1,842 @@
+package cql2
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/paulmach/orb"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Feature is a feature a compiled filter is evaluated against
+type Feature struct {
+	ID         interface{}
+	Properties map[string]interface{}
+	Geometry   orb.Geometry
+}
+
+// truth is a value of three-valued logic
+type truth int8
+
+const (
+	truthUnknown truth = iota
+	truthFalse
+	truthTrue
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return truthTrue
+	}
+	return truthFalse
+}
+
+func (t truth) not() truth {
+	switch t {
+	case truthTrue:
+		return truthFalse
+	case truthFalse:
+		return truthTrue
+	}
+	return truthUnknown
+}
+
+// value returns the truth as a value, with unknown as NULL
+func (t truth) value() interface{} {
+	if t == truthUnknown {
+		return nil
+	}
+	return t == truthTrue
+}
+
+// date is the value of a date, as midnight UTC
+type date time.Time
+
+type evalFunc func(f *Feature) (interface{}, error)
+type predFunc func(f *Feature) (truth, error)
+
+// CompileOption is a function to set options of the compilation of filters
+type CompileOption func(*compiler)
+
+type compiler struct {
+	// metric of DWITHIN distances
+	metric distanceMetric
+	// name of the property standing for the feature geometry
+	geometryName string
+}
+
+// WithGeometryProperty sets the name of the property standing for the feature geometry
+// in spatial predicates (by default "geometry").
+func WithGeometryProperty(name string) CompileOption {
+	return func(c *compiler) {
+		c.geometryName = name
+	}
+}
+
+// WithHaversineDistance evaluates DWITHIN distances in metres between lon/lat coordinates,
+// along great circles of a spherical earth.
+// By default distances are planar, in the units of the coordinates.
+func WithHaversineDistance() CompileOption {
+	return func(c *compiler) {
+		c.metric = haversineMetric
+	}
+}
+
+// CompileText compiles CQL text into a function evaluating the filter against features.
+// See Compile.
+func CompileText(cqlStr string, opts ...CompileOption) (func(Feature) (bool, error), error) {
+	node, err := Parse(cqlStr)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return func(Feature) (bool, error) { return true, nil }, nil
+	}
+	return Compile(node, opts...)
+}
+
+// Compile compiles a syntax tree into a function evaluating the filter against features.
+// NULL (nil or missing) values follow SQL three-valued logic:
+// comparisons with NULL are unknown, and a feature matches only if the filter is true.
+// Numbers may be any Go numeric type or json.Number, and timestamps and dates
+// may be time.Time values or strings in RFC 3339 format.
+//
+// Spatial predicates are evaluated on orb geometries, or GeoJSON geometries decoded as JSON objects,
+// in the properties of a feature.
+// The geometry property (see WithGeometryProperty) is the feature geometry
+// if the feature has no property of the name, and other missing properties are NULL.
+// Geometries are not transformed: literals must use the coordinates of the features.
+func Compile(node Node, opts ...CompileOption) (func(Feature) (bool, error), error) {
+	c := &compiler{metric: planarMetric, geometryName: "geometry"}
+	for _, opt := range opts {
+		opt(c)
+	}
+	pred, err := c.compilePred(node)
+	if err != nil {
+		return nil, err
+	}
+	return func(f Feature) (bool, error) {
+		t, err := pred(&f)
+		return t == truthTrue, err
+	}, nil
+}
+
+func (c *compiler) compilePred(node Node) (predFunc, error) {
+	op, isOp := node.(*Op)
+	if !isOp {
+		//-- a boolean literal, property or function
+		val, err := c.compileValue(node)
+		if err != nil {
+			return nil, err
+		}
+		return func(f *Feature) (truth, error) {
+			v, err := val(f)
+			if err != nil || v == nil {
+				return truthUnknown, err
+			}
+			b, ok := v.(bool)
+			if !ok {
+				return truthUnknown, fmt.Errorf("%v is not a boolean", v)
+			}
+			return truthOf(b), nil
+		}, nil
+	}
+	switch op.Name {
+	case "and", "or":
+		return c.compileLogical(op)
+	case "not":
+		arg, err := c.compilePred(op.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return func(f *Feature) (truth, error) {
+			t, err := arg(f)
+			return t.not(), err
+		}, nil
+	case "isTrue", "isFalse", "isUnknown":
+		arg, err := c.compilePred(op.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		want := map[string]truth{"isTrue": truthTrue, "isFalse": truthFalse, "isUnknown": truthUnknown}[op.Name]
+		return func(f *Feature) (truth, error) {
+			t, err := arg(f)
+			return truthOf(t == want), err
+		}, nil
+	case "isNull":
+		arg, err := c.compileValue(op.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return func(f *Feature) (truth, error) {
+			v, err := arg(f)
+			return truthOf(v == nil), err
+		}, nil
+	case "=", "<>", "<", ">", "<=", ">=":
+		return c.compileComparison(op)
+	case "like", "ilike":
+		return c.compileLike(op)
+	case "between":
+		return c.compileBetween(op)
+	case "in":
+		return c.compileIn(op)
+	case "dwithin":
+		return c.compileDistance(op)
+	}
+	if _, isSpatial := spatialPredicates[op.Name]; isSpatial {
+		return c.compileSpatial(op)
+	}
+	if _, isArithmetic := scalarPrec[op.Name]; isArithmetic {
+		return nil, fmt.Errorf("%s is not a predicate", op.Name)
+	}
+	return nil, fmt.Errorf("%s cannot be evaluated in memory", strings.ToUpper(op.Name))
+}
+
+func (c *compiler) compilePreds(nodes []Node) ([]predFunc, error) {
+	preds := make([]predFunc, len(nodes))
+	for i, node := range nodes {
+		pred, err := c.compilePred(node)
+		if err != nil {
+			return nil, err
+		}
+		preds[i] = pred
+	}
+	return preds, nil
+}
+
+func (c *compiler) compileValues(nodes []Node) ([]evalFunc, error) {
+	vals := make([]evalFunc, len(nodes))
+	for i, node := range nodes {
+		val, err := c.compileValue(node)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
+func (c *compiler) compileLogical(op *Op) (predFunc, error) {
+	args, err := c.compilePreds(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	//-- AND stops at false and OR at true, otherwise an unknown argument makes the result unknown
+	decisive, other := truthFalse, truthTrue
+	if op.Name == "or" {
+		decisive, other = truthTrue, truthFalse
+	}
+	return func(f *Feature) (truth, error) {
+		result := other
+		for _, arg := range args {
+			t, err := arg(f)
+			if err != nil {
+				return truthUnknown, err
+			}
+			if t == decisive {
+				return decisive, nil
+			}
+			if t == truthUnknown {
+				result = truthUnknown
+			}
+		}
+		return result, nil
+	}, nil
+}
+
+func (c *compiler) compileComparison(op *Op) (predFunc, error) {
+	args, err := c.compileValues(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (truth, error) {
+		left, err := args[0](f)
+		if err != nil {
+			return truthUnknown, err
+		}
+		right, err := args[1](f)
+		if err != nil {
+			return truthUnknown, err
+		}
+		return compareOp(op.Name, left, right)
+	}, nil
+}
+
+// compareOp applies a comparison operator to two values
+func compareOp(name string, left interface{}, right interface{}) (truth, error) {
+	if left == nil || right == nil {
+		return truthUnknown, nil
+	}
+	c, err := compareValues(left, right)
+	if err != nil {
+		return truthUnknown, err
+	}
+	switch name {
+	case "=":
+		return truthOf(c == 0), nil
+	case "<>":
+		return truthOf(c != 0), nil
+	case "<":
+		return truthOf(c < 0), nil
+	case ">":
+		return truthOf(c > 0), nil
+	case "<=":
+		return truthOf(c <= 0), nil
+	}
+	return truthOf(c >= 0), nil
+}
+
+// compareValues orders two non-NULL values.
+// Strings are converted to timestamps or dates when compared with them.
+func compareValues(left interface{}, right interface{}) (int, error) {
+	switch l := left.(type) {
+	case float64:
+		if r, ok := right.(float64); ok {
+			return compareOrdered(l, r), nil
+		}
+		if r, ok := right.(*big.Int); ok && !math.IsNaN(l) {
+			return new(big.Float).SetFloat64(l).Cmp(new(big.Float).SetInt(r)), nil
+		}
+	case *big.Int:
+		switch r := right.(type) {
+		case *big.Int:
+			return l.Cmp(r), nil
+		case float64:
+			c, err := compareValues(right, left)
+			return -c, err
+		}
+	case string:
+		switch r := right.(type) {
+		case string:
+			return strings.Compare(l, r), nil
+		case time.Time, date:
+			lt, err := parseTime(l)
+			if err != nil {
+				return 0, err
+			}
+			return compareValues(lt, r)
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			return compareOrdered(boolRank(l), boolRank(r)), nil
+		}
+	case time.Time:
+		switch r := right.(type) {
+		case time.Time:
+			return l.Compare(r), nil
+		case date:
+			return l.Compare(time.Time(r)), nil
+		case string:
+			c, err := compareValues(right, left)
+			return -c, err
+		}
+	case date:
+		return compareValues(time.Time(l), right)
+	}
+	return 0, fmt.Errorf("cannot compare %s with %s", describeValue(left), describeValue(right))
+}
+
+// describeValue returns the type and value of a value for error messages
+func describeValue(v interface{}) string {
+	switch val := v.(type) {
+	case float64, *big.Int:
+		return "number " + formatValue(v)
+	case string:
+		return "string " + strconv.Quote(val)
+	case bool:
+		return fmt.Sprintf("boolean %t", val)
+	case time.Time:
+		return "timestamp " + formatValue(v)
+	case date:
+		return "date " + formatValue(v)
+	case orb.Geometry:
+		return "geometry " + val.GeoJSONType()
+	}
+	return fmt.Sprintf("%T %v", v, v)
+}
+
+func compareOrdered(l float64, r float64) int {
+	switch {
+	case l < r:
+		return -1
+	case l > r:
+		return 1
+	}
+	return 0
+}
+
+func boolRank(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a valid timestamp", s)
+}
+
+func (c *compiler) compileLike(op *Op) (predFunc, error) {
+	args, err := c.compileValues(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	ignoreCase := op.Name == "ilike"
+	//-- a literal pattern is compiled once
+	var literal *regexp.Regexp
+	if pattern, ok := op.Args[1].(*StringLit); ok {
+		if literal, err = likeRegexp(pattern.Value, ignoreCase); err != nil {
+			return nil, err
+		}
+	}
+	return func(f *Feature) (truth, error) {
+		v, err := args[0](f)
+		if err != nil || v == nil {
+			return truthUnknown, err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return truthUnknown, fmt.Errorf("%s requires a string, not %v", strings.ToUpper(op.Name), v)
+		}
+		re := literal
+		if re == nil {
+			p, err := args[1](f)
+			if err != nil || p == nil {
+				return truthUnknown, err
+			}
+			pattern, ok := p.(string)
+			if !ok {
+				return truthUnknown, fmt.Errorf("%s requires a string pattern, not %v", strings.ToUpper(op.Name), p)
+			}
+			if re, err = likeRegexp(pattern, ignoreCase); err != nil {
+				return truthUnknown, err
+			}
+		}
+		return truthOf(re.MatchString(s)), nil
+	}, nil
+}
+
+// likeRegexp converts a LIKE pattern to a regular expression.
+// % matches any text, _ any character, and \ escapes the next character.
+func likeRegexp(pattern string, ignoreCase bool) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^(?s")
+	if ignoreCase {
+		sb.WriteString("i")
+	}
+	sb.WriteString(")")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("LIKE pattern '%s' ends with an escape character", pattern)
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func (c *compiler) compileBetween(op *Op) (predFunc, error) {
+	args, err := c.compileValues(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (truth, error) {
+		vals := make([]interface{}, len(args))
+		for i, arg := range args {
+			v, err := arg(f)
+			if err != nil {
+				return truthUnknown, err
+			}
+			vals[i] = v
+		}
+		lower, err := compareOp(">=", vals[0], vals[1])
+		if err != nil {
+			return truthUnknown, err
+		}
+		upper, err := compareOp("<=", vals[0], vals[2])
+		if err != nil {
+			return truthUnknown, err
+		}
+		return andTruth(lower, upper), nil
+	}, nil
+}
+
+func andTruth(a truth, b truth) truth {
+	if a == truthFalse || b == truthFalse {
+		return truthFalse
+	}
+	if a == truthUnknown || b == truthUnknown {
+		return truthUnknown
+	}
+	return truthTrue
+}
+
+func (c *compiler) compileIn(op *Op) (predFunc, error) {
+	args, err := c.compileValues(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (truth, error) {
+		v, err := args[0](f)
+		if err != nil {
+			return truthUnknown, err
+		}
+		//-- true if equal to an item, otherwise unknown if an item is NULL
+		result := truthFalse
+		for _, item := range args[1:] {
+			iv, err := item(f)
+			if err != nil {
+				return truthUnknown, err
+			}
+			t, err := compareOp("=", v, iv)
+			if err != nil {
+				return truthUnknown, err
+			}
+			if t == truthTrue {
+				return truthTrue, nil
+			}
+			if t == truthUnknown {
+				result = truthUnknown
+			}
+		}
+		return result, nil
+	}, nil
+}
+
+func (c *compiler) compileValue(node Node) (evalFunc, error) {
+	switch n := node.(type) {
+	case *Property:
+		return func(f *Feature) (interface{}, error) {
+			return normalizeValue(c.property(f, n.Name))
+		}, nil
+	case *StringLit:
+		return constant(n.Value), nil
+	case *NumberLit:
+		if n.Text != "" {
+			return constant(numberValue(n.Text, n.Value)), nil
+		}
+		return constant(n.Value), nil
+	case *BoolLit:
+		return constant(n.Value), nil
+	case *TimestampLit:
+		if n.Now {
+			return func(*Feature) (interface{}, error) { return time.Now(), nil }, nil
+		}
+		t, err := parseTime(n.Value)
+		if err != nil {
+			return nil, err
+		}
+		return constant(t), nil
+	case *DateLit:
+		t, err := time.Parse(dateLayouts[0], n.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid date", n.Value)
+		}
+		return constant(date(t)), nil
+	case *Call:
+		return c.compileCall(n)
+	case *Op:
+		if _, isArithmetic := scalarPrec[n.Name]; isArithmetic {
+			return c.compileArithmetic(n)
+		}
+		//-- a predicate used as a value
+		pred, err := c.compilePred(n)
+		if err != nil {
+			return nil, err
+		}
+		return func(f *Feature) (interface{}, error) {
+			t, err := pred(f)
+			return t.value(), err
+		}, nil
+	}
+	return nil, fmt.Errorf("%T cannot be evaluated in memory", node)
+}
+
+// compileGeometry compiles a geometry expression, a property or a literal
+func (c *compiler) compileGeometry(node Node) (func(f *Feature) (orb.Geometry, error), error) {
+	prop, isProperty := node.(*Property)
+	if !isProperty {
+		geom, err := orbGeometry(node)
+		if err != nil {
+			return nil, err
+		}
+		return func(*Feature) (orb.Geometry, error) { return geom, nil }, nil
+	}
+	return func(f *Feature) (orb.Geometry, error) {
+		v := c.property(f, prop.Name)
+		if v == nil {
+			return nil, nil
+		}
+		if geom, ok := v.(orb.Geometry); ok {
+			return geom, nil
+		}
+		geom, err := geoJSONValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("property %s is not a geometry: %v", prop.Name, err)
+		}
+		return geom, nil
+	}, nil
+}
+
+// property returns the value of a property of a feature, which for the geometry property
+// is the feature geometry if the feature has no property of the name
+func (c *compiler) property(f *Feature, name string) interface{} {
+	v, ok := f.Properties[name]
+	if !ok && name == c.geometryName && f.Geometry != nil {
+		return f.Geometry
+	}
+	return v
+}
+
+// compileGeometries compiles the two geometry arguments of a spatial predicate
+func (c *compiler) compileGeometries(op *Op) (func(f *Feature) (orb.Geometry, orb.Geometry, error), error) {
+	left, err := c.compileGeometry(op.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.compileGeometry(op.Args[1])
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (orb.Geometry, orb.Geometry, error) {
+		a, err := left(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		b, err := right(f)
+		return a, b, err
+	}, nil
+}
+
+func (c *compiler) compileSpatial(op *Op) (predFunc, error) {
+	args, err := c.compileGeometries(op)
+	if err != nil {
+		return nil, err
+	}
+	test := spatialPredicates[op.Name]
+	return func(f *Feature) (truth, error) {
+		a, b, err := args(f)
+		if err != nil || a == nil || b == nil {
+			return truthUnknown, err
+		}
+		return truthOf(test(relate(a, b))), nil
+	}, nil
+}
+
+func (c *compiler) compileDistance(op *Op) (predFunc, error) {
+	args, err := c.compileGeometries(op)
+	if err != nil {
+		return nil, err
+	}
+	dist, ok := op.Args[2].(*NumberLit)
+	if !ok {
+		return nil, fmt.Errorf("DWITHIN requires a numeric distance")
+	}
+	metric := c.metric
+	return func(f *Feature) (truth, error) {
+		a, b, err := args(f)
+		if err != nil || a == nil || b == nil {
+			return truthUnknown, err
+		}
+		return truthOf(geometryDistance(a, b, metric) <= dist.Value), nil
+	}, nil
+}
+
+func constant(v interface{}) evalFunc {
+	return func(*Feature) (interface{}, error) { return v, nil }
+}
+
+// maxExactInt is the magnitude up to which all integers are exact as float64
+const maxExactInt = 1 << 53
+
+// numberValue returns the value of the text of a number for evaluation:
+// a *big.Int for an integer which a float64 cannot represent exactly, and else the float64
+func numberValue(text string, v float64) interface{} {
+	if i, ok := new(big.Int).SetString(text, 10); ok && i.CmpAbs(big.NewInt(maxExactInt)) > 0 {
+		return i
+	}
+	return v
+}
+
+// normalizeValue converts a property value to the types used in evaluation:
+// numbers to float64, or to *big.Int for integers which a float64 cannot represent exactly,
+// and nil pointers to nil
+func normalizeValue(v interface{}) (interface{}, error) {
+	switch val := v.(type) {
+	case nil, string, bool, float64, time.Time:
+		return v, nil
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return numberValue(val.String(), f), nil
+	case float32:
+		return float64(val), nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if i := rv.Int(); i > maxExactInt || i < -maxExactInt {
+			return big.NewInt(i), nil
+		}
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u > maxExactInt {
+			return new(big.Int).SetUint64(u), nil
+		}
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return normalizeValue(rv.Elem().Interface())
+	}
+	return v, nil
+}
+
+func (c *compiler) compileArithmetic(op *Op) (evalFunc, error) {
+	args, err := c.compileValues(op.Args)
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (interface{}, error) {
+		left, err := args[0](f)
+		if err != nil {
+			return nil, err
+		}
+		right, err := args[1](f)
+		if err != nil || left == nil || right == nil {
+			return nil, err
+		}
+		if op.Name == "||" {
+			return formatValue(left) + formatValue(right), nil
+		}
+		l, lok := floatValue(left)
+		r, rok := floatValue(right)
+		if !lok || !rok {
+			return nil, fmt.Errorf("%s requires numbers, not %s and %s", op.Name, describeValue(left), describeValue(right))
+		}
+		switch op.Name {
+		case "+":
+			return l + r, nil
+		case "-":
+			return l - r, nil
+		case "*":
+			return l * r, nil
+		case "^":
+			return math.Pow(l, r), nil
+		}
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		if op.Name == "%" {
+			return math.Mod(l, r), nil
+		}
+		return l / r, nil
+	}, nil
+}
+
+// floatValue returns a number as a float64, which arithmetic is done in
+func floatValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case *big.Int:
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return f, true
+	}
+	return 0, false
+}
+
+// formatValue formats a value for concatenation
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case *big.Int:
+		return val.String()
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case date:
+		return time.Time(val).Format(dateLayouts[0])
+	}
+	return fmt.Sprint(v)
+}
+
+// goFunctionForCql are the functions which can be evaluated in memory
+var goFunctionForCql = map[string]func(string) string{
+	"casei":   strings.ToLower,
+	"accenti": removeAccents,
+}
+
+func (c *compiler) compileCall(call *Call) (evalFunc, error) {
+	fun, ok := goFunctionForCql[call.Name]
+	if !ok {
+		return nil, fmt.Errorf("function %s cannot be evaluated in memory", call.Name)
+	}
+	if len(call.Args) != 1 {
+		return nil, fmt.Errorf("function %s takes 1 argument", call.Name)
+	}
+	arg, err := c.compileValue(call.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	return func(f *Feature) (interface{}, error) {
+		v, err := arg(f)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("function %s requires a string, not %v", call.Name, v)
+		}
+		return fun(s), nil
+	}, nil
+}
+
+// removeAccents removes the diacritical marks from text
+func removeAccents(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package cql2_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var place = cql2.Feature{
+	ID: 1,
+	Properties: map[string]interface{}{
+		"name":    "Zürich",
+		"pop":     421878,
+		"area":    json.Number("87.88"),
+		"capital": false,
+		"founded": "0015-01-01",
+		"updated": time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC),
+		"note":    nil,
+	},
+}
+
+var _ = Describe("Evaluation", func() {
+	DescribeTable("matches",
+		func(cqlStr string, expected bool) {
+			filter, err := cql2.CompileText(cqlStr)
+			Expect(err).To(BeNil())
+			actual, err := filter(place)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("empty filter", "", true),
+		Entry("string equality", "name = 'Zürich'", true),
+		Entry("integer comparison", "pop > 400000", true),
+		Entry("json number", "area < 100", true),
+		Entry("boolean property", "capital", false),
+		Entry("NOT binds before AND", "NOT capital AND pop > 1", true),
+		Entry("arithmetic", "pop / area > 4000 AND pop % 2 = 0", true),
+		Entry("precedence", "2 + 3 * 4 = 14 AND 2 ^ 3 = 8", true),
+		Entry("concatenation", "name || '!' = 'Zürich!'", true),
+		Entry("like", "name LIKE 'Z_rich'", true),
+		Entry("like with escape", "name LIKE 'Z\\%%'", false),
+		Entry("ilike", "name ILIKE 'z%'", true),
+		Entry("not like", "name NOT LIKE '%x%'", true),
+		Entry("casei", "CASEI(name) = 'zürich'", true),
+		Entry("accenti", "ACCENTI(name) = 'Zurich'", true),
+		Entry("between", "pop BETWEEN 400000 AND 500000", true),
+		Entry("not between", "pop NOT BETWEEN 400000 AND 500000", false),
+		Entry("in", "name IN ('Bern', 'Zürich')", true),
+		Entry("not in", "pop NOT IN (1, 2)", true),
+		Entry("timestamp property", "updated > 2023-01-01T00:00:00Z", true),
+		Entry("date string property", "founded < DATE('1000-01-01')", true),
+		Entry("timestamp with date", "updated BETWEEN 2023-05-01 AND 2023-05-02", true),
+		Entry("now", "updated < NOW()", true),
+		Entry("is true", "capital IS FALSE AND pop > 1 IS TRUE", true),
+	)
+
+	DescribeTable("NULL values",
+		func(cqlStr string, expected bool) {
+			filter, err := cql2.CompileText(cqlStr)
+			Expect(err).To(BeNil())
+			actual, err := filter(place)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("comparison with NULL", "note = 'x'", false),
+		Entry("negated comparison with NULL", "NOT note = 'x'", false),
+		Entry("missing property", "missing <> 1", false),
+		Entry("is null", "note IS NULL AND missing IS NULL", true),
+		Entry("is not null", "name IS NOT NULL", true),
+		Entry("unknown OR true", "note = 'x' OR pop > 1", true),
+		Entry("unknown AND false", "NOT (note = 'x' AND pop < 1)", true),
+		Entry("unknown AND true", "NOT (note = 'x' AND pop > 1)", false),
+		Entry("in with NULL item", "NOT pop IN (1, note)", false),
+		Entry("is unknown", "note = 'x' IS UNKNOWN", true),
+		Entry("is not true", "note = 'x' IS NOT TRUE", true),
+		Entry("group is true", "(capital OR pop > 1) IS TRUE", true),
+		Entry("group is unknown", "(note = 'x' AND pop > 1) IS UNKNOWN", true),
+		Entry("arithmetic with NULL", "pop + note IS NULL", true),
+		Entry("like on NULL", "note NOT LIKE 'x'", false),
+	)
+
+	DescribeTable("errors",
+		func(cqlStr string) {
+			filter, err := cql2.CompileText(cqlStr)
+			if err == nil {
+				_, err = filter(place)
+			}
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("incomparable values", "name > 5"),
+		Entry("non-boolean term", "name AND pop > 1"),
+		Entry("division by zero", "pop / 0 > 1"),
+		Entry("like on number", "pop LIKE '4%'"),
+		Entry("unknown function", "upper(name) = 'X'"),
+		Entry("invalid timestamp string", "name > 2020-01-01T00:00:00Z"),
+	)
+
+	It("decodes GeoJSON features with JSON numbers", func() {
+		f, err := cql2.UnmarshalFeature([]byte(`{"type": "Feature", "id": 9007199254740993,
+			"properties": {"pop": 421878, "shape": {"type": "Point", "coordinates": [1, 1]}},
+			"geometry": null}`))
+		Expect(err).To(BeNil())
+		Expect(f.ID).To(Equal(json.Number("9007199254740993")))
+		Expect(f.Properties["pop"]).To(Equal(json.Number("421878")))
+		filter, err := cql2.CompileText("pop > 400000 AND intersects(shape, ENVELOPE(0, 0, 2, 2))")
+		Expect(err).To(BeNil())
+		Expect(filter(f)).To(BeTrue())
+	})
+
+	DescribeTable("compares large integers exactly",
+		func(cqlStr string, expected bool) {
+			f, err := cql2.UnmarshalFeature([]byte(`{"type": "Feature", "properties": {"id": 9007199254740993, "n": 9007199254740992}, "geometry": null}`))
+			Expect(err).To(BeNil())
+			filter, err := cql2.CompileText(cqlStr)
+			Expect(err).To(BeNil())
+			Expect(filter(f)).To(Equal(expected))
+		},
+		Entry("equal", "id = 9007199254740993", true),
+		Entry("next integer", "id = 9007199254740992", false),
+		Entry("greater than the integer below", "id > 9007199254740992", true),
+		Entry("float below", "id > 9007199254740992.0", true),
+		Entry("integer property", "n = 9007199254740992 AND n < id", true),
+		Entry("IN", "id IN (9007199254740992, 9007199254740994)", false),
+	)
+
+	It("reports the types of incomparable values", func() {
+		filter, err := cql2.CompileText("pop = '421878'")
+		Expect(err).To(BeNil())
+		_, err = filter(place)
+		Expect(err).To(MatchError(`cannot compare number 421878 with string "421878"`))
+	})
+
+	It("reports properties which are not geometries", func() {
+		filter, err := cql2.CompileText("intersects(name, POINT(0 0))")
+		Expect(err).To(BeNil())
+		_, err = filter(place)
+		Expect(err).To(MatchError(ContainSubstring("property name is not a geometry")))
+	})
+})
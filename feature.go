@@ -0,0 +1,57 @@
+package cql2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/paulmach/orb"
+)
+
+// UnmarshalFeature decodes a GeoJSON feature for evaluation by a compiled filter.
+// Numbers are decoded as json.Number, so that large integers keep their precision.
+func UnmarshalFeature(data []byte) (Feature, error) {
+	var obj struct {
+		Type       string                 `json:"type"`
+		ID         interface{}            `json:"id"`
+		Properties map[string]interface{} `json:"properties"`
+		Geometry   json.RawMessage        `json:"geometry"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return Feature{}, err
+	}
+	if obj.Type != "Feature" {
+		return Feature{}, fmt.Errorf("%q is not a Feature", obj.Type)
+	}
+	f := Feature{ID: obj.ID, Properties: obj.Properties}
+	if len(obj.Geometry) == 0 || string(obj.Geometry) == "null" {
+		return f, nil
+	}
+	geom, err := decodeGeoJSON(obj.Geometry)
+	if err != nil {
+		return Feature{}, err
+	}
+	if f.Geometry, err = orbGeometryOf(geom); err != nil {
+		return Feature{}, err
+	}
+	return f, nil
+}
+
+// geoJSONValue converts a GeoJSON geometry decoded as a JSON object, such as a property value, to an orb geometry
+func geoJSONValue(v interface{}) (orb.Geometry, error) {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v is not a GeoJSON object", v)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	geom, err := decodeGeoJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return orbGeometryOf(geom)
+}
@@ -0,0 +1,243 @@
+package cql2
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// EWKB flags for the Z and M dimensions
+const (
+	ewkbZFlag = 0x80000000
+	ewkbMFlag = 0x40000000
+)
+
+// geometry types by WKB type code
+var wkbGeometryTypes = map[uint32]string{
+	1:  "Point",
+	2:  "LineString",
+	3:  "Polygon",
+	4:  "MultiPoint",
+	5:  "MultiLineString",
+	6:  "MultiPolygon",
+	7:  "GeometryCollection",
+	8:  "CircularString",
+	9:  "CompoundCurve",
+	10: "CurvePolygon",
+	11: "MultiCurve",
+	12: "MultiSurface",
+}
+
+// decodeWKB decodes a hex-encoded WKB or EWKB geometry
+func decodeWKB(hexText string) (*Geometry, error) {
+	data, err := hex.DecodeString(hexText)
+	if err != nil {
+		return nil, err
+	}
+	r := &wkbReader{data: data}
+	geom := r.geometry()
+	if r.err == nil && r.pos != len(r.data) {
+		r.err = fmt.Errorf("%d trailing bytes", len(r.data)-r.pos)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return geom, nil
+}
+
+type wkbReader struct {
+	data  []byte
+	pos   int
+	order binary.ByteOrder
+	err   error
+}
+
+func (r *wkbReader) bytes(n int) []byte {
+	if r.err != nil {
+		return make([]byte, n)
+	}
+	if r.pos+n > len(r.data) {
+		r.err = fmt.Errorf("too short")
+		return make([]byte, n)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *wkbReader) uint32() uint32 {
+	return r.order.Uint32(r.bytes(4))
+}
+
+func (r *wkbReader) float64() float64 {
+	return math.Float64frombits(r.order.Uint64(r.bytes(8)))
+}
+
+func (r *wkbReader) geometry() *Geometry {
+	switch order := r.bytes(1)[0]; order {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		r.err = fmt.Errorf("invalid byte order %d", order)
+		return nil
+	}
+	code := r.uint32()
+	geom := &Geometry{}
+	if code&ewkbSRIDFlag != 0 {
+		geom.SRID = int(r.uint32())
+	}
+	//-- EWKB flags the dimensions, ISO WKB adds 1000 for Z, 2000 for M and 3000 for ZM
+	hasZ := code&ewkbZFlag != 0
+	hasM := code&ewkbMFlag != 0
+	code &^= ewkbZFlag | ewkbMFlag | ewkbSRIDFlag
+	switch code / 1000 {
+	case 1:
+		hasZ = true
+	case 2:
+		hasM = true
+	case 3:
+		hasZ, hasM = true, true
+	}
+	if hasZ {
+		geom.Dim = "Z"
+	}
+	if hasM {
+		geom.Dim += "M"
+	}
+	geomType, ok := wkbGeometryTypes[code%1000]
+	if !ok {
+		r.err = fmt.Errorf("unsupported geometry type %d", code)
+		return nil
+	}
+	geom.Type = geomType
+	size := 2
+	if hasZ {
+		size++
+	}
+	if hasM {
+		size++
+	}
+	switch geomType {
+	case "Point":
+		coord := make([]float64, size)
+		empty := true
+		for i := range coord {
+			coord[i] = r.float64()
+			empty = empty && math.IsNaN(coord[i])
+		}
+		//-- an empty point has NaN coordinates
+		if !empty {
+			geom.Coords = [][]float64{coord}
+		}
+	case "LineString", "CircularString":
+		geom.Coords = r.coords(size)
+	case "Polygon":
+		n := r.count()
+		for i := 0; i < n && r.err == nil; i++ {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "LineString", Dim: geom.Dim, Coords: r.coords(size)})
+		}
+	default:
+		n := r.count()
+		for i := 0; i < n && r.err == nil; i++ {
+			part := r.geometry()
+			if part != nil {
+				//-- the SRID of the collection applies to its parts
+				part.SRID = 0
+				geom.Parts = append(geom.Parts, part)
+			}
+		}
+	}
+	return geom
+}
+
+func (r *wkbReader) count() int {
+	n := int(r.uint32())
+	//-- every element takes at least 8 bytes
+	if r.err == nil && n > (len(r.data)-r.pos)/8+1 {
+		r.err = fmt.Errorf("invalid count %d", n)
+		return 0
+	}
+	return n
+}
+
+func (r *wkbReader) coords(size int) [][]float64 {
+	n := r.count()
+	var list [][]float64
+	for i := 0; i < n && r.err == nil; i++ {
+		coord := make([]float64, size)
+		for j := range coord {
+			coord[j] = r.float64()
+		}
+		list = append(list, coord)
+	}
+	return list
+}
+
+// decodeGeoJSON decodes a GeoJSON geometry
+func decodeGeoJSON(data []byte) (*Geometry, error) {
+	var obj struct {
+		Type        string            `json:"type"`
+		Coordinates json.RawMessage   `json:"coordinates"`
+		Geometries  []json.RawMessage `json:"geometries"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	if !geoJSONGeometryTypes[obj.Type] {
+		return nil, fmt.Errorf("%q is not a geometry type", obj.Type)
+	}
+	geom := &Geometry{Type: obj.Type}
+	if obj.Type == "GeometryCollection" {
+		for _, member := range obj.Geometries {
+			part, err := decodeGeoJSON(member)
+			if err != nil {
+				return nil, err
+			}
+			geom.Parts = append(geom.Parts, part)
+		}
+		return geom, nil
+	}
+	if len(obj.Coordinates) == 0 {
+		return nil, fmt.Errorf("%s has no coordinates", obj.Type)
+	}
+	var err error
+	switch obj.Type {
+	case "Point":
+		var coord []float64
+		if err = json.Unmarshal(obj.Coordinates, &coord); err == nil && len(coord) > 0 {
+			geom.Coords = [][]float64{coord}
+		}
+	case "LineString":
+		err = json.Unmarshal(obj.Coordinates, &geom.Coords)
+	case "MultiPoint":
+		var points [][]float64
+		err = json.Unmarshal(obj.Coordinates, &points)
+		for _, point := range points {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "Point", Coords: [][]float64{point}})
+		}
+	case "Polygon", "MultiLineString":
+		var lines [][][]float64
+		err = json.Unmarshal(obj.Coordinates, &lines)
+		for _, line := range lines {
+			geom.Parts = append(geom.Parts, &Geometry{Type: "LineString", Coords: line})
+		}
+	case "MultiPolygon":
+		var polys [][][][]float64
+		err = json.Unmarshal(obj.Coordinates, &polys)
+		for _, poly := range polys {
+			polygon := &Geometry{Type: "Polygon"}
+			for _, ring := range poly {
+				polygon.Parts = append(polygon.Parts, &Geometry{Type: "LineString", Coords: ring})
+			}
+			geom.Parts = append(geom.Parts, polygon)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return geom, nil
+}
@@ -6,7 +6,9 @@ require (
 	github.com/antlr4-go/antlr/v4 v4.13.0
 	github.com/onsi/ginkgo/v2 v2.17.1
 	github.com/onsi/gomega v1.33.0
+	github.com/paulmach/orb v0.13.0
 	github.com/rs/zerolog v1.32.0
+	golang.org/x/text v0.14.0
 )
 
 require (
@@ -19,7 +21,6 @@ require (
 	golang.org/x/exp v0.0.0-20230515195305-f3d0a9c9a5cc // indirect
 	golang.org/x/net v0.23.0 // indirect
 	golang.org/x/sys v0.18.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/tools v0.17.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,400 @@
+package cql2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonOp is an operator, predicate or function in CQL2-JSON
+type jsonOp struct {
+	Op   string        `json:"op"`
+	Args []interface{} `json:"args"`
+}
+
+type jsonProperty struct {
+	Property string `json:"property"`
+}
+
+type jsonTimestamp struct {
+	Timestamp string `json:"timestamp"`
+}
+
+type jsonDate struct {
+	Date string `json:"date"`
+}
+
+type jsonBbox struct {
+	Bbox []float64 `json:"bbox"`
+}
+
+type jsonGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+type jsonCollection struct {
+	Type       string        `json:"type"`
+	Geometries []interface{} `json:"geometries"`
+}
+
+// TranspileToJSON converts CQL text to CQL2-JSON
+func TranspileToJSON(cqlStr string) ([]byte, error) {
+	node, err := Parse(cqlStr)
+	if err != nil || node == nil {
+		return nil, err
+	}
+	return FormatJSON(node)
+}
+
+// FormatJSON encodes a syntax tree as CQL2-JSON.
+// Geometries are encoded as GeoJSON, and envelopes as bbox.
+// ILIKE is encoded as LIKE on CASEI values, and the IS TRUE, IS FALSE and IS UNKNOWN tests
+// with AND, NOT and isNull.
+// The operators with no CQL2 equivalent (|| and DWITHIN), curved geometries,
+// geometries with M values and geometries with an SRID other than 4326 cannot be encoded.
+func FormatJSON(node Node) ([]byte, error) {
+	val, err := jsonValue(node)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(val)
+}
+
+func jsonValue(node Node) (interface{}, error) {
+	switch n := node.(type) {
+	case *Op:
+		return jsonOperator(n)
+	case *Call:
+		return jsonCall(n.Name, n.Args)
+	case *Property:
+		return jsonProperty{Property: n.Name}, nil
+	case *StringLit:
+		return n.Value, nil
+	case *NumberLit:
+		if n.Text != "" {
+			return json.Number(n.Text), nil
+		}
+		return n.Value, nil
+	case *BoolLit:
+		return n.Value, nil
+	case *TimestampLit:
+		if n.Now {
+			return jsonCall("now", nil)
+		}
+		return jsonTimestamp{Timestamp: n.Value}, nil
+	case *DateLit:
+		return jsonDate{Date: n.Value}, nil
+	case *Envelope:
+		if err := checkJSONSRID(n.SRID); err != nil {
+			return nil, err
+		}
+		return jsonBbox{Bbox: n.Bounds}, nil
+	case *Geometry:
+		if err := checkJSONSRID(n.SRID); err != nil {
+			return nil, err
+		}
+		return jsonGeoJSON(n)
+	}
+	return nil, fmt.Errorf("unknown node %T", node)
+}
+
+func jsonCall(name string, args []Node) (interface{}, error) {
+	op := jsonOp{Op: name, Args: make([]interface{}, 0, len(args))}
+	for _, arg := range args {
+		val, err := jsonValue(arg)
+		if err != nil {
+			return nil, err
+		}
+		op.Args = append(op.Args, val)
+	}
+	return op, nil
+}
+
+func jsonOperator(op *Op) (interface{}, error) {
+	switch op.Name {
+	case "||", "dwithin":
+		return nil, fmt.Errorf("%s has no CQL2-JSON encoding", op.Name)
+	case "ilike":
+		//-- ILIKE is LIKE ignoring case
+		return jsonCall("like", []Node{
+			&Call{Name: "casei", Args: op.Args[:1]},
+			&Call{Name: "casei", Args: op.Args[1:]},
+		})
+	case "in":
+		val, err := jsonValue(op.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		list, err := jsonCall("", op.Args[1:])
+		if err != nil {
+			return nil, err
+		}
+		return jsonOp{Op: "in", Args: []interface{}{val, list.(jsonOp).Args}}, nil
+	case "isTrue", "isFalse":
+		//-- x IS TRUE is true only if x is true, and not null
+		val := op.Args[0]
+		if op.Name == "isFalse" {
+			val = &Op{Name: "not", Args: []Node{val}}
+		}
+		return jsonCall("and", []Node{
+			val,
+			&Op{Name: "not", Args: []Node{&Op{Name: "isNull", Args: op.Args}}},
+		})
+	case "isUnknown":
+		return jsonCall("isNull", op.Args)
+	}
+	return jsonCall(op.Name, op.Args)
+}
+
+// number of arguments of the CQL2-JSON operators, or -1 for two or more
+var jsonOperatorArity = map[string]int{
+	"and":          -1,
+	"or":           -1,
+	"not":          1,
+	"=":            2,
+	"<>":           2,
+	"<":            2,
+	">":            2,
+	"<=":           2,
+	">=":           2,
+	"like":         2,
+	"between":      3,
+	"in":           2,
+	"isNull":       1,
+	"+":            2,
+	"-":            2,
+	"*":            2,
+	"/":            2,
+	"%":            2,
+	"^":            2,
+	"s_intersects": 2,
+	"s_disjoint":   2,
+	"s_within":     2,
+	"s_contains":   2,
+	"s_equals":     2,
+	"s_touches":    2,
+	"s_overlaps":   2,
+	"s_crosses":    2,
+}
+
+// ParseJSON decodes a CQL2-JSON filter into a syntax tree.
+// Operations which are not operators are function calls.
+// The temporal and array operators, and intervals, are not supported,
+// nor property names which cannot be written in CQL2 text.
+// Errors start with the JSON path of the invalid value, such as $.args[1].
+func ParseJSON(data []byte) (Node, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	return decodeJSONValue(data, "$")
+}
+
+// jsonPathError returns an error about the value at a path of a CQL2-JSON document
+func jsonPathError(path string, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+func decodeJSONValue(data json.RawMessage, path string) (Node, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, jsonPathError(path, "CQL2-JSON value expected")
+	}
+	switch data[0] {
+	case '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, jsonPathError(path, "%v", err)
+		}
+		return &StringLit{Value: s}, nil
+	case 't', 'f':
+		var b bool
+		if err := json.Unmarshal(data, &b); err != nil {
+			return nil, jsonPathError(path, "%v", err)
+		}
+		return &BoolLit{Value: b}, nil
+	case '{':
+		return decodeJSONObject(data, path)
+	case '[':
+		return nil, jsonPathError(path, "array %s is only valid as the list of IN", data)
+	case 'n':
+		return nil, jsonPathError(path, "null is not a CQL2 value")
+	}
+	var num json.Number
+	if err := json.Unmarshal(data, &num); err != nil {
+		return nil, jsonPathError(path, "%v", err)
+	}
+	v, err := strconv.ParseFloat(num.String(), 64)
+	if err != nil {
+		return nil, jsonPathError(path, "number %s is out of range", num)
+	}
+	return &NumberLit{Value: v, Text: formatNumberText(num.String())}, nil
+}
+
+func decodeJSONObject(data json.RawMessage, path string) (Node, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, jsonPathError(path, "%v", err)
+	}
+	if _, ok := obj["op"]; ok {
+		return decodeJSONOp(data, path)
+	}
+	if _, ok := obj["type"]; ok {
+		geom, err := decodeGeoJSON(data)
+		if err != nil {
+			return nil, jsonPathError(path, "%v", err)
+		}
+		return geom, nil
+	}
+	for _, key := range []string{"property", "timestamp", "date"} {
+		raw, ok := obj[key]
+		if !ok {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, jsonPathError(path+"."+key, "%v", err)
+		}
+		switch key {
+		case "property":
+			if !isPropertyName(s) {
+				return nil, jsonPathError(path+"."+key, "property name %q cannot be written in CQL2 text", s)
+			}
+			return &Property{Name: s}, nil
+		case "timestamp":
+			//-- CQL2-JSON timestamps are RFC 3339 date-times
+			if !isTimeValue(s, timestampLayouts[:1]) {
+				return nil, jsonPathError(path+"."+key, "%q is not a valid timestamp", s)
+			}
+			return &TimestampLit{Value: s}, nil
+		}
+		if !isTimeValue(s, dateLayouts) {
+			return nil, jsonPathError(path+"."+key, "%q is not a valid date", s)
+		}
+		return &DateLit{Value: s}, nil
+	}
+	if raw, ok := obj["bbox"]; ok {
+		var bounds []float64
+		if err := json.Unmarshal(raw, &bounds); err != nil {
+			return nil, jsonPathError(path+".bbox", "%v", err)
+		}
+		if len(bounds) != 4 && len(bounds) != 6 {
+			return nil, jsonPathError(path+".bbox", "bbox must have 4 or 6 numbers")
+		}
+		return &Envelope{Bounds: bounds}, nil
+	}
+	return nil, jsonPathError(path, "unknown CQL2-JSON object %s", data)
+}
+
+func decodeJSONOp(data json.RawMessage, path string) (Node, error) {
+	var obj struct {
+		Op   string            `json:"op"`
+		Args []json.RawMessage `json:"args"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, jsonPathError(path, "%v", err)
+	}
+	arity, isOperator := jsonOperatorArity[obj.Op]
+	switch {
+	case !isOperator && (strings.HasPrefix(obj.Op, "t_") || strings.HasPrefix(obj.Op, "a_")):
+		return nil, jsonPathError(path, "operator %s is not supported", obj.Op)
+	case !isOperator && strings.ToLower(obj.Op) == "now" && len(obj.Args) == 0:
+		return &TimestampLit{Now: true}, nil
+	case !isOperator:
+		args, err := decodeJSONArgs(obj.Args, path+".args")
+		if err != nil {
+			return nil, err
+		}
+		return &Call{Name: strings.ToLower(obj.Op), Args: args}, nil
+	case arity < 0 && len(obj.Args) < 2:
+		return nil, jsonPathError(path, "%s needs at least 2 arguments", obj.Op)
+	case arity >= 0 && len(obj.Args) != arity:
+		return nil, jsonPathError(path, "%s needs %d arguments", obj.Op, arity)
+	}
+	if obj.Op == "in" {
+		//-- the second argument of IN is the array of items
+		var list []json.RawMessage
+		if err := json.Unmarshal(obj.Args[1], &list); err != nil || len(list) == 0 {
+			return nil, jsonPathError(path+".args[1]", "in needs a non-empty array of items")
+		}
+		val, err := decodeJSONValue(obj.Args[0], path+".args[0]")
+		if err != nil {
+			return nil, err
+		}
+		items, err := decodeJSONArgs(list, path+".args[1]")
+		if err != nil {
+			return nil, err
+		}
+		return &Op{Name: obj.Op, Args: append([]Node{val}, items...)}, nil
+	}
+	nodes, err := decodeJSONArgs(obj.Args, path+".args")
+	if err != nil {
+		return nil, err
+	}
+	return &Op{Name: obj.Op, Args: nodes}, nil
+}
+
+// decodeJSONArgs decodes the items of the array at a path
+func decodeJSONArgs(args []json.RawMessage, path string) ([]Node, error) {
+	nodes := make([]Node, 0, len(args))
+	for i, arg := range args {
+		node, err := decodeJSONValue(arg, fmt.Sprintf("%s[%d]", path, i))
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func checkJSONSRID(srid int) error {
+	if srid != 0 && srid != 4326 {
+		return fmt.Errorf("geometry with SRID %d has no CQL2-JSON encoding", srid)
+	}
+	return nil
+}
+
+// jsonGeoJSON returns the GeoJSON of a geometry
+func jsonGeoJSON(geom *Geometry) (interface{}, error) {
+	if !geoJSONGeometryTypes[geom.Type] {
+		return nil, fmt.Errorf("%s has no GeoJSON encoding", wktGeometryTypes[geom.Type])
+	}
+	if geom.Dim == "M" || geom.Dim == "ZM" {
+		return nil, fmt.Errorf("geometry with M values has no GeoJSON encoding")
+	}
+	if geom.Type == "GeometryCollection" {
+		coll := jsonCollection{Type: geom.Type, Geometries: make([]interface{}, 0, len(geom.Parts))}
+		for _, part := range geom.Parts {
+			val, err := jsonGeoJSON(part)
+			if err != nil {
+				return nil, err
+			}
+			coll.Geometries = append(coll.Geometries, val)
+		}
+		return coll, nil
+	}
+	return jsonGeometry{Type: geom.Type, Coordinates: geoJSONCoordinates(geom)}, nil
+}
+
+// geoJSONCoordinates returns the nested coordinate arrays of a geometry
+func geoJSONCoordinates(geom *Geometry) interface{} {
+	switch geom.Type {
+	case "Point":
+		if len(geom.Coords) == 0 {
+			return []float64{}
+		}
+		return geom.Coords[0]
+	case "LineString":
+		if geom.Coords == nil {
+			return [][]float64{}
+		}
+		return geom.Coords
+	}
+	parts := make([]interface{}, 0, len(geom.Parts))
+	for _, part := range geom.Parts {
+		parts = append(parts, geoJSONCoordinates(part))
+	}
+	return parts
+}
@@ -0,0 +1,138 @@
+package cql2_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("CQL2 JSON", func() {
+	DescribeTable("encodes",
+		func(cqlStr string, expected string) {
+			actual, err := cql2.TranspileToJSON(cqlStr)
+			Expect(err).To(BeNil())
+			Expect(actual).To(MatchJSON(expected))
+		},
+		Entry("comparison", "name = 'Oslo'", `{"op": "=", "args": [{"property": "name"}, "Oslo"]}`),
+		Entry("boolean operators", "a = 1 AND NOT b < 2.5 OR c",
+			`{"op": "or", "args": [
+				{"op": "and", "args": [
+					{"op": "=", "args": [{"property": "a"}, 1]},
+					{"op": "not", "args": [{"op": "<", "args": [{"property": "b"}, 2.5]}]}
+				]},
+				{"property": "c"}
+			]}`),
+		Entry("n-ary AND", "a AND b AND c", `{"op": "and", "args": [{"property": "a"}, {"property": "b"}, {"property": "c"}]}`),
+		Entry("like", "name NOT LIKE 'O%'", `{"op": "not", "args": [{"op": "like", "args": [{"property": "name"}, "O%"]}]}`),
+		Entry("ilike", "name ILIKE 'o%'",
+			`{"op": "like", "args": [{"op": "casei", "args": [{"property": "name"}]}, {"op": "casei", "args": ["o%"]}]}`),
+		Entry("between", "x BETWEEN 1 AND 2", `{"op": "between", "args": [{"property": "x"}, 1, 2]}`),
+		Entry("in", "x IN ('a', 'b')", `{"op": "in", "args": [{"property": "x"}, ["a", "b"]]}`),
+		Entry("is null", "x IS NOT NULL", `{"op": "not", "args": [{"op": "isNull", "args": [{"property": "x"}]}]}`),
+		Entry("is true", "flag IS TRUE",
+			`{"op": "and", "args": [{"property": "flag"}, {"op": "not", "args": [{"op": "isNull", "args": [{"property": "flag"}]}]}]}`),
+		Entry("is unknown", "flag IS UNKNOWN", `{"op": "isNull", "args": [{"property": "flag"}]}`),
+		Entry("arithmetic", "x * (y + 1) > 10", `{"op": ">", "args": [{"op": "*", "args": [{"property": "x"}, {"op": "+", "args": [{"property": "y"}, 1]}]}, 10]}`),
+		Entry("functions", "CASEI(name) = casei('OSLO') AND true",
+			`{"op": "and", "args": [{"op": "=", "args": [{"op": "casei", "args": [{"property": "name"}]}, {"op": "casei", "args": ["OSLO"]}]}, true]}`),
+		Entry("temporal literals", "t > 2020-01-01T10:00:00Z AND d = DATE('2020-01-01')",
+			`{"op": "and", "args": [
+				{"op": ">", "args": [{"property": "t"}, {"timestamp": "2020-01-01T10:00:00Z"}]},
+				{"op": "=", "args": [{"property": "d"}, {"date": "2020-01-01"}]}
+			]}`),
+		Entry("point", "intersects(geom, POINT(1 2))",
+			`{"op": "s_intersects", "args": [{"property": "geom"}, {"type": "Point", "coordinates": [1, 2]}]}`),
+		Entry("polygon", "s_within(geom, SRID=4326;POLYGON((0 0, 1 0, 1 1, 0 0)))",
+			`{"op": "s_within", "args": [{"property": "geom"}, {"type": "Polygon", "coordinates": [[[0, 0], [1, 0], [1, 1], [0, 0]]]}]}`),
+		Entry("multi point", "intersects(geom, MULTIPOINT((1 2), (3 4)))",
+			`{"op": "s_intersects", "args": [{"property": "geom"}, {"type": "MultiPoint", "coordinates": [[1, 2], [3, 4]]}]}`),
+		Entry("collection", "intersects(geom, GEOMETRYCOLLECTION(POINT Z(1 2 3), LINESTRING(0 0, 1 1)))",
+			`{"op": "s_intersects", "args": [{"property": "geom"}, {"type": "GeometryCollection", "geometries": [
+				{"type": "Point", "coordinates": [1, 2, 3]},
+				{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}
+			]}]}`),
+		Entry("envelope", "intersects(geom, ENVELOPE(0, 1, 2, 3))",
+			`{"op": "s_intersects", "args": [{"property": "geom"}, {"bbox": [0, 1, 2, 3]}]}`),
+		Entry("GeoJSON", `intersects(geom, {"type": "Point", "coordinates": [1, 2]})`,
+			`{"op": "s_intersects", "args": [{"property": "geom"}, {"type": "Point", "coordinates": [1, 2]}]}`),
+	)
+
+	DescribeTable("cannot encode",
+		func(cqlStr string) {
+			_, err := cql2.TranspileToJSON(cqlStr)
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("concatenation", "a || 'b' = 'ab'"),
+		Entry("distance", "dwithin(geom, POINT(0 0), 10)"),
+		Entry("projected geometry", "intersects(geom, SRID=3857;POINT(0 0))"),
+		Entry("curve", "intersects(geom, CIRCULARSTRING(0 0, 1 1, 2 0))"),
+		Entry("M values", "intersects(geom, POINT M(0 0 5))"),
+	)
+
+	DescribeTable("decodes",
+		func(data string, expected string) {
+			node, err := cql2.ParseJSON([]byte(data))
+			Expect(err).To(BeNil())
+			Expect(cql2.FormatText(node)).To(Equal(expected))
+		},
+		Entry("comparison", `{"op": "=", "args": [{"property": "name"}, "Oslo"]}`, "name = 'Oslo'"),
+		Entry("boolean operators", `{"op": "or", "args": [
+				{"op": "and", "args": [{"op": "=", "args": [{"property": "a"}, 1]}, {"op": "not", "args": [{"property": "b"}]}]},
+				{"property": "c"}
+			]}`, "a = 1 AND NOT b OR c"),
+		Entry("in", `{"op": "in", "args": [{"property": "id"}, [1, 2, 3]]}`, "id IN (1, 2, 3)"),
+		Entry("like casei", `{"op": "like", "args": [{"op": "casei", "args": [{"property": "name"}]}, {"op": "casei", "args": ["jo%"]}]}`,
+			"CASEI(name) LIKE CASEI('jo%')"),
+		Entry("temporal", `{"op": ">", "args": [{"property": "t"}, {"timestamp": "2020-01-01T00:00:00Z"}]}`,
+			"t > TIMESTAMP('2020-01-01T00:00:00Z')"),
+		Entry("date", `{"op": "<", "args": [{"property": "d"}, {"date": "2020-01-01"}]}`, "d < DATE('2020-01-01')"),
+		Entry("now", `{"op": "<", "args": [{"property": "t"}, {"op": "now", "args": []}]}`, "t < NOW()"),
+		Entry("arithmetic", `{"op": "*", "args": [{"op": "+", "args": [{"property": "a"}, 1]}, 2]}`, "(a + 1) * 2"),
+		Entry("geometry", `{"op": "s_intersects", "args": [{"property": "geom"}, {"type": "Point", "coordinates": [1, 2]}]}`,
+			"S_INTERSECTS(geom, POINT(1 2))"),
+		Entry("bbox", `{"op": "s_within", "args": [{"property": "geom"}, {"bbox": [0, 1, 2, 3]}]}`,
+			"S_WITHIN(geom, BBOX(0, 1, 2, 3))"),
+		Entry("function", `{"op": "Len", "args": [{"property": "name"}]}`, "len(name)"),
+		Entry("quoted property", `{"op": "=", "args": [{"property": "and"}, 1]}`, `"and" = 1`),
+		Entry("exact numbers", `{"op": "in", "args": [{"property": "id"}, [9007199254740993, 1.50, 1E-9]]}`,
+			"id IN (9007199254740993, 1.5, 1e-09)"),
+	)
+
+	It("encodes exact numbers", func() {
+		actual, err := cql2.TranspileToJSON("id = 9007199254740993")
+		Expect(err).To(BeNil())
+		Expect(string(actual)).To(Equal(`{"op":"=","args":[{"property":"id"},9007199254740993]}`))
+	})
+
+	DescribeTable("cannot decode",
+		func(data string) {
+			_, err := cql2.ParseJSON([]byte(data))
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("invalid JSON", `{"op": "=", "args": [`),
+		Entry("wrong argument count", `{"op": "=", "args": [{"property": "a"}]}`),
+		Entry("in without list", `{"op": "in", "args": [{"property": "a"}, 1]}`),
+		Entry("temporal operator", `{"op": "t_before", "args": [{"property": "t"}, {"date": "2020-01-01"}]}`),
+		Entry("interval", `{"op": "=", "args": [{"property": "t"}, {"interval": ["2020-01-01", ".."]}]}`),
+		Entry("null", `{"op": "=", "args": [{"property": "a"}, null]}`),
+	)
+
+	DescribeTable("reports the JSON path of errors",
+		func(data string, message string) {
+			_, err := cql2.ParseJSON([]byte(data))
+			Expect(err).To(MatchError(message))
+		},
+		Entry("argument count", `{"op": "and", "args": [{"op": "="}, true]}`, "$.args[0]: = needs 2 arguments"),
+		Entry("number out of range", `{"op": "=", "args": [{"property": "x"}, 1e400]}`, "$.args[1]: number 1e400 is out of range"),
+		Entry("invalid timestamp", `{"op": ">", "args": [{"property": "t"}, {"timestamp": "garbage"}]}`,
+			`$.args[1].timestamp: "garbage" is not a valid timestamp`),
+		Entry("timestamp without time zone", `{"op": ">", "args": [{"property": "t"}, {"timestamp": "2020-01-01T00:00:00"}]}`,
+			`$.args[1].timestamp: "2020-01-01T00:00:00" is not a valid timestamp`),
+		Entry("invalid date", `{"op": "<", "args": [{"property": "d"}, {"date": "2020-02-30"}]}`,
+			`$.args[1].date: "2020-02-30" is not a valid date`),
+		Entry("property name", `{"op": "=", "args": [{"property": "my prop"}, 1]}`,
+			`$.args[0].property: property name "my prop" cannot be written in CQL2 text`),
+		Entry("IN item", `{"op": "in", "args": [{"property": "x"}, [1, null]]}`, "$.args[1][1]: null is not a CQL2 value"),
+	)
+})
@@ -0,0 +1,149 @@
+package cql2
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// WithCoordinatePrecision rounds the coordinates of geometry literals
+// to the given number of decimals.
+func WithCoordinatePrecision(decimals int) Option {
+	return func(l *cqlListener) {
+		l.coordDecimals = decimals
+	}
+}
+
+// WithSimplification reduces the lines and polygon rings of geometry literals
+// to at most maxVertices vertices, by repeatedly removing the vertex
+// which contributes the least area (Visvalingam-Whyatt).
+// Lines keep at least 2 vertices, and rings at least 4.
+func WithSimplification(maxVertices int) Option {
+	return func(l *cqlListener) {
+		l.simplifyVertices = maxVertices
+	}
+}
+
+// formatOrdinate returns the text for a literal ordinate, rounded if required
+func (l *cqlListener) formatOrdinate(num string) string {
+	if l.coordDecimals < 0 {
+		return num
+	}
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsInf(v, 0) {
+		return num
+	}
+	scale := math.Pow(10, float64(l.coordDecimals))
+	v = math.Round(v*scale) / scale
+	if v == 0 {
+		//-- avoid -0
+		v = 0
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// isSimplified reports whether a coordinate list is a line or ring to be simplified
+func (l *cqlListener) isSimplified(ctx *CoordListContext) bool {
+	if l.simplifyVertices <= 0 || len(ctx.AllCoordinate()) <= l.simplifyVertices {
+		return false
+	}
+	switch ctx.GetParent().(type) {
+	case *LinestringContext, *MultiLinestringContext, *PolygonDefContext:
+		return true
+	}
+	return false
+}
+
+func (l *cqlListener) writeSimplifiedCoordList(ctx *CoordListContext, sb *strings.Builder) {
+	sb.WriteString("(")
+	for i, coord := range l.simplifiedCoordinates(ctx) {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		for j, num := range coord.AllNumericLiteral() {
+			if j > 0 {
+				sb.WriteString(" ")
+			}
+			sb.WriteString(l.formatOrdinate(num.GetText()))
+		}
+	}
+	sb.WriteString(")")
+}
+
+// simplifiedCoordinates returns the coordinates kept when simplifying a line or ring
+func (l *cqlListener) simplifiedCoordinates(ctx *CoordListContext) []ICoordinateContext {
+	coords := ctx.AllCoordinate()
+	_, isRing := ctx.GetParent().(*PolygonDefContext)
+	minVertices := 2
+	if isRing {
+		minVertices = 4
+	}
+	maxVertices := l.simplifyVertices
+	if maxVertices < minVertices {
+		maxVertices = minVertices
+	}
+	indexes := simplifyIndexes(coords, maxVertices)
+	kept := make([]ICoordinateContext, len(indexes))
+	for i, idx := range indexes {
+		kept[i] = coords[idx]
+	}
+	return kept
+}
+
+// emittedCoordinates collects the coordinates of a parse subtree which are kept in the SQL
+func (l *cqlListener) emittedCoordinates(tree antlr.Tree) []ICoordinateContext {
+	var coords []ICoordinateContext
+	for _, t := range tree.GetChildren() {
+		switch c := t.(type) {
+		case *CoordinateContext:
+			coords = append(coords, c)
+		case *CoordListContext:
+			if l.isSimplified(c) {
+				coords = append(coords, l.simplifiedCoordinates(c)...)
+			} else {
+				coords = append(coords, l.emittedCoordinates(c)...)
+			}
+		default:
+			coords = append(coords, l.emittedCoordinates(t)...)
+		}
+	}
+	return coords
+}
+
+// simplifyIndexes returns the indexes of the coordinates kept when reducing
+// a line to maxVertices vertices. The end points are always kept,
+// so rings remain closed.
+func simplifyIndexes(coords []ICoordinateContext, maxVertices int) []int {
+	type vertex struct {
+		x, y float64
+	}
+	pts := make([]vertex, len(coords))
+	for i, c := range coords {
+		ords, ok := ordinates(c)
+		if !ok {
+			ords = []float64{0, 0}
+		}
+		pts[i] = vertex{ords[0], ords[1]}
+	}
+	keep := make([]int, len(pts))
+	for i := range keep {
+		keep[i] = i
+	}
+	area := func(k int) float64 {
+		a, b, c := pts[keep[k-1]], pts[keep[k]], pts[keep[k+1]]
+		return math.Abs((b.x-a.x)*(c.y-a.y)-(c.x-a.x)*(b.y-a.y)) / 2
+	}
+	for len(keep) > maxVertices {
+		minK := 1
+		minArea := area(1)
+		for k := 2; k < len(keep)-1; k++ {
+			if a := area(k); a < minArea {
+				minK, minArea = k, a
+			}
+		}
+		keep = append(keep[:minK], keep[minK+1:]...)
+	}
+	return keep
+}
@@ -0,0 +1,69 @@
+package cql2_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("Coordinate precision", func() {
+	DescribeTable("rounding",
+		func(cqlStr string, decimals int, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithCoordinatePrecision(decimals))
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("point", "intersects(geom, POINT(1.23456789012345678 -45.000049))", 4,
+			"ST_Intersects(\"geom\",'SRID=4326;POINT(1.2346 -45)'::geometry)"),
+		Entry("point Z", "intersects(geom, POINT Z(1.25 2.35 3.45))", 1,
+			"ST_Intersects(\"geom\",'SRID=4326;POINT Z(1.3 2.4 3.5)'::geometry)"),
+		Entry("no decimals", "intersects(geom, LINESTRING(0.4 -0.4, 10.6 1e2))", 0,
+			"ST_Intersects(\"geom\",'SRID=4326;LINESTRING(0 0,11 100)'::geometry)"),
+		Entry("envelope", "intersects(geom, ENVELOPE(1.11111,2.22222,3.33333,4.44444))", 2,
+			"ST_Intersects(\"geom\",ST_MakeEnvelope(1.11,2.22,3.33,4.44,4326))"),
+		Entry("non-geometry numbers are unchanged", "x = 1.23456", 2,
+			"\"x\" = 1.23456"),
+	)
+
+	DescribeTable("simplification",
+		func(cqlStr string, maxVertices int, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithSimplification(maxVertices))
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("line", "intersects(geom, LINESTRING(0 0, 1 0.01, 2 0, 3 5, 4 0))", 3,
+			"ST_Intersects(\"geom\",'SRID=4326;LINESTRING(0 0,3 5,4 0)'::geometry)"),
+		Entry("ring stays closed", "intersects(geom, POLYGON((0 0, 5 0.1, 10 0, 10 10, 0 10, 0 0)))", 5,
+			"ST_Intersects(\"geom\",'SRID=4326;POLYGON((0 0,10 0,10 10,0 10,0 0))'::geometry)"),
+		Entry("ring keeps 4 vertices", "intersects(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", 2,
+			"ST_Intersects(\"geom\",'SRID=4326;POLYGON((0 0,10 10,0 10,0 0))'::geometry)"),
+		Entry("short line unchanged", "intersects(geom, LINESTRING(0 0, 1 1))", 3,
+			"ST_Intersects(\"geom\",'SRID=4326;LINESTRING(0 0,1 1)'::geometry)"),
+		Entry("multipolygon", "intersects(geom, MULTIPOLYGON(((0 0, 5 0.1, 10 0, 10 10, 0 0)), ((20 20, 21 21, 20 21, 20 20))))", 4,
+			"ST_Intersects(\"geom\",'SRID=4326;MULTIPOLYGON(((0 0,10 0,10 10,0 0)),((20 20,21 21,20 21,20 20)))'::geometry)"),
+	)
+
+	It("combines rounding and simplification", func() {
+		actual, err := cql2.TranspileToSQL("intersects(geom, LINESTRING(0.123 0.123, 1.001 0.001, 2.456 0.789))", 4326, 4326,
+			cql2.WithCoordinatePrecision(1), cql2.WithSimplification(2))
+		Expect(err).To(BeNil())
+		Expect(actual).To(Equal("ST_Intersects(\"geom\",'SRID=4326;LINESTRING(0.1 0.1,2.5 0.8)'::geometry)"))
+	})
+
+	DescribeTable("bounding box prefilter of the emitted literal",
+		func(cqlStr string, opt cql2.Option, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithIndexPrefilter(), opt)
+			Expect(err).To(BeNil())
+			Expect(strings.TrimSpace(actual)).To(Equal(sql))
+		},
+		Entry("rounded", "intersects(geom, POINT(1.26 2.26))", cql2.WithCoordinatePrecision(1),
+			"\"geom\" && ST_MakeEnvelope(1.3,2.3,1.3,2.3,4326) AND ST_Intersects(\"geom\",'SRID=4326;POINT(1.3 2.3)'::geometry)"),
+		Entry("simplified", "intersects(geom, LINESTRING(0 0, 1 -5, 2 0.01, 3 0))", cql2.WithSimplification(3),
+			"\"geom\" && ST_MakeEnvelope(0,-5,3,0,4326) AND ST_Intersects(\"geom\",'SRID=4326;LINESTRING(0 0,1 -5,3 0)'::geometry)"),
+	)
+})
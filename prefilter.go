@@ -0,0 +1,164 @@
+package cql2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// WithIndexPrefilter generates spatial predicates in a form which lets
+// the query planner use a spatial (GiST) index on the geometry columns:
+//   - predicates implying bounding box interaction are prefixed
+//     with an explicit bounding box test (col && box AND ST_Intersects(...))
+//   - DISJOINT is expressed as NOT ST_Intersects, so that NOT DISJOINT
+//     becomes an index-friendly ST_Intersects
+//   - the bounding box of a geometry literal is computed during translation
+func WithIndexPrefilter() Option {
+	return func(l *cqlListener) {
+		l.indexPrefilter = true
+	}
+}
+
+// spatial functions which are only true if the bounding boxes of the arguments interact
+var bboxImpliedFunction = map[string]bool{
+	"ST_Contains":   true,
+	"ST_Crosses":    true,
+	"ST_Equals":     true,
+	"ST_Intersects": true,
+	"ST_Overlaps":   true,
+	"ST_Touches":    true,
+	"ST_Within":     true,
+}
+
+// indexedSpatialSQL generates SQL for a spatial predicate, optionally negated
+func (l *cqlListener) indexedSpatialSQL(ctx *SpatialPredicateContext, negate bool) string {
+	fun := toPostGISFunction(ctx.SpatialOperator().GetText())
+	if fun == "ST_Disjoint" {
+		fun = "ST_Intersects"
+		negate = !negate
+	}
+	geom1 := ctx.GeomExpression(0)
+	geom2 := ctx.GeomExpression(1)
+	sql := fun + "(" + sqlFor(geom1) + "," + sqlFor(geom2) + ")"
+	if negate {
+		//-- a negated bounding box test cannot use the index
+		return "NOT " + sql
+	}
+	if !bboxImpliedFunction[fun] || !hasProperty(geom1, geom2) || l.hasGeography(geom1, geom2) {
+		return sql
+	}
+	return l.sqlBboxTest(geom1, geom2, "") + " AND " + sql
+}
+
+// indexedDistanceSQL generates SQL for a distance predicate with a bounding box prefilter
+func (l *cqlListener) indexedDistanceSQL(ctx *DistancePredicateContext) string {
+	geom1 := ctx.GeomExpression(0)
+	geom2 := ctx.GeomExpression(1)
+	dist := ctx.NumericLiteral().GetText()
+	sql := toPostGISFunction(ctx.DistanceOperator().GetText()) + "(" + sqlFor(geom1) + "," + sqlFor(geom2) + "," + dist + ")"
+	if !hasProperty(geom1, geom2) || l.hasGeography(geom1, geom2) {
+		return sql
+	}
+	return l.sqlBboxTest(geom1, geom2, dist) + " AND " + sql
+}
+
+// sqlBboxTest generates a bounding box interaction test, with the box of a literal
+// expanded by distance if given
+func (l *cqlListener) sqlBboxTest(geom1 IGeomExpressionContext, geom2 IGeomExpressionContext, distance string) string {
+	box1 := l.sqlBbox(geom1)
+	box2 := l.sqlBbox(geom2)
+	if distance != "" {
+		if geom2.PropertyName() == nil {
+			box2 = fmt.Sprintf("ST_Expand(%s,%s)", box2, distance)
+		} else {
+			box1 = fmt.Sprintf("ST_Expand(%s,%s)", box1, distance)
+		}
+	}
+	return box1 + " && " + box2
+}
+
+// sqlBbox returns SQL for a geometry expression to be used in a bounding box test.
+// The box of a WKT literal is computed from its coordinates if possible,
+// as they are written in the SQL: rounded, and without the vertices removed by simplification.
+func (l *cqlListener) sqlBbox(ctx IGeomExpressionContext) string {
+	sql := sqlFor(ctx)
+	lit := ctx.GeomLiteral()
+	srid := l.sridOf(ctx)
+	if lit == nil || l.targetSRID != srid {
+		return sql
+	}
+	if _, isEnv := lit.GetChild(0).(*EnvelopeContext); isEnv {
+		return sql
+	}
+	//-- the box of the control points of an arc does not contain the arc
+	if containsCircularString(lit) {
+		return sql
+	}
+	coords := l.emittedCoordinates(lit)
+	if len(coords) == 0 {
+		return sql
+	}
+	var min, max [2]string
+	var minVal, maxVal [2]float64
+	for i, c := range coords {
+		for axis := 0; axis < 2; axis++ {
+			text := l.formatOrdinate(c.NumericLiteral(axis).GetText())
+			v, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return sql
+			}
+			if i == 0 || v < minVal[axis] {
+				min[axis], minVal[axis] = text, v
+			}
+			if i == 0 || v > maxVal[axis] {
+				max[axis], maxVal[axis] = text, v
+			}
+		}
+	}
+	return fmt.Sprintf("ST_MakeEnvelope(%s,%s,%s,%s,%d)", min[0], min[1], max[0], max[1], srid)
+}
+
+func hasProperty(geom1 IGeomExpressionContext, geom2 IGeomExpressionContext) bool {
+	return geom1.PropertyName() != nil || geom2.PropertyName() != nil
+}
+
+// hasGeography reports whether a geography column is compared.
+// Its box is not in the units of the literal, and the geography functions use the index themselves.
+func (l *cqlListener) hasGeography(geom1 IGeomExpressionContext, geom2 IGeomExpressionContext) bool {
+	for _, geom := range []IGeomExpressionContext{geom1, geom2} {
+		if geom.PropertyName() != nil && l.isGeography(geom.PropertyName()) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCircularString(tree antlr.Tree) bool {
+	for _, t := range tree.GetChildren() {
+		if _, ok := t.(*CircularStringContext); ok || containsCircularString(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// spatialPredicateOf returns the spatial predicate which is the entire boolean expression, if any
+func spatialPredicateOf(expr IBooleanExpressionContext) *SpatialPredicateContext {
+	for {
+		paren, ok := expr.(*BoolExprParenContext)
+		if !ok {
+			break
+		}
+		if paren.TruthValue() != nil {
+			return nil
+		}
+		expr = paren.BooleanExpression()
+	}
+	term, ok := expr.(*BoolExprTermContext)
+	if !ok || term.BooleanTerm().Predicate() == nil || term.BooleanTerm().TruthValue() != nil {
+		return nil
+	}
+	sp, _ := term.BooleanTerm().Predicate().SpatialPredicate().(*SpatialPredicateContext)
+	return sp
+}
@@ -0,0 +1,47 @@
+package cql2_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("Index prefilter", func() {
+	DescribeTable("spatial predicates",
+		func(cqlStr string, filterSRID int, sourceSRID int, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, filterSRID, sourceSRID, cql2.WithIndexPrefilter())
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("intersects point", "intersects(geom, POINT(1 2))", 4326, 4326,
+			"\"geom\" && ST_MakeEnvelope(1,2,1,2,4326) AND ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("contains polygon", "contains(geom, POLYGON((0 0, 0 9, 9 0, 0 0)))", 4326, 4326,
+			"\"geom\" && ST_MakeEnvelope(0,0,9,9,4326) AND ST_Contains(\"geom\",'SRID=4326;POLYGON((0 0,0 9,9 0,0 0))'::geometry)"),
+		Entry("literal first", "within(LINESTRING(-1 5, 3 -2), geom)", 4326, 4326,
+			"ST_MakeEnvelope(-1,-2,3,5,4326) && \"geom\" AND ST_Within('SRID=4326;LINESTRING(-1 5,3 -2)'::geometry,\"geom\")"),
+		Entry("envelope", "intersects(geom, ENVELOPE(1,2,3,4))", 4326, 4326,
+			"\"geom\" && ST_MakeEnvelope(1,2,3,4,4326) AND ST_Intersects(\"geom\",ST_MakeEnvelope(1,2,3,4,4326))"),
+		Entry("transformed literal", "intersects(geom, POINT(1 2))", 4326, 3857,
+			"\"geom\" && ST_Transform('SRID=4326;POINT(1 2)'::geometry,3857) AND ST_Intersects(\"geom\",ST_Transform('SRID=4326;POINT(1 2)'::geometry,3857))"),
+		Entry("circularstring", "intersects(geom, CIRCULARSTRING(0 0, 1 1, 2 0))", 4326, 4326,
+			"\"geom\" && 'SRID=4326;CIRCULARSTRING(0 0,1 1,2 0)'::geometry AND ST_Intersects(\"geom\",'SRID=4326;CIRCULARSTRING(0 0,1 1,2 0)'::geometry)"),
+		Entry("two properties", "intersects(geom, geom2)", 4326, 4326,
+			"\"geom\" && \"geom2\" AND ST_Intersects(\"geom\",\"geom2\")"),
+		Entry("disjoint", "disjoint(geom, POINT(1 2))", 4326, 4326,
+			"NOT ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("not disjoint", "NOT disjoint(geom, POINT(1 2))", 4326, 4326,
+			"\"geom\" && ST_MakeEnvelope(1,2,1,2,4326) AND ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("not intersects", "NOT intersects(geom, POINT(1 2))", 4326, 4326,
+			"NOT ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("not parenthesized intersects", "NOT (intersects(geom, POINT(1 2)))", 4326, 4326,
+			"NOT ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("combined with or", "x = 1 OR intersects(geom, POINT(1 2))", 4326, 4326,
+			"\"x\" = 1 OR \"geom\" && ST_MakeEnvelope(1,2,1,2,4326) AND ST_Intersects(\"geom\",'SRID=4326;POINT(1 2)'::geometry)"),
+		Entry("dwithin", "dwithin(geom, POINT(1 2), 10)", 3857, 3857,
+			"\"geom\" && ST_Expand(ST_MakeEnvelope(1,2,1,2,3857),10) AND ST_DWithin(\"geom\",'SRID=3857;POINT(1 2)'::geometry,10)"),
+	)
+})
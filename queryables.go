@@ -0,0 +1,284 @@
+package cql2
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Queryable declares the type of a property which can be used in a filter.
+// Types and formats are those of the JSON Schema used for OGC API queryables:
+// Type is "string", "number", "integer" or "boolean",
+// and Format is "date" or "date-time" for temporal strings.
+// Geometry properties have a Format starting with "geometry" (e.g. "geometry-polygon").
+// Enum lists the allowed values of the property, if they are restricted.
+// Title, Description and Role (the x-ogc-role annotation, e.g. "id" or "primary-instant")
+// are only used in the queryables schema.
+// Column is the name of the table column holding the property, if it differs from the property name,
+// and SRID is the SRID of a geometry column, if it differs from the source SRID.
+// Geography marks a geometry property held in a column of the Postgres geography type.
+type Queryable struct {
+	Type        string
+	Format      string
+	Enum        []interface{}
+	Title       string
+	Description string
+	Role        string
+	Column      string
+	SRID        int
+	Geography   bool
+}
+
+// Queryables declares the properties of a collection by name
+type Queryables map[string]Queryable
+
+// WithQueryables declares the types of properties.
+// Literals compared with a declared property are cast to its type.
+// Predicates are checked against the declarations before SQL is generated,
+// and violations (such as comparisons of incompatible types, LIKE on a number,
+// a spatial predicate on a non-geometry property or a value not in an enum)
+// are reported as a TypeError.
+func WithQueryables(queryables Queryables) Option {
+	return func(l *cqlListener) {
+		l.queryables = queryables
+	}
+}
+
+// Detail returns the declared type of a queryable with its format, e.g. "string (date-time)",
+// or an empty string if neither is declared
+func (q Queryable) Detail() string {
+	switch {
+	case q.Format == "":
+		return q.Type
+	case q.Type == "":
+		return q.Format
+	}
+	return q.Type + " (" + q.Format + ")"
+}
+
+// scalarType returns the CQL value type of a queryable
+func (q Queryable) scalarType() string {
+	switch {
+	case strings.HasPrefix(q.Format, "geometry"):
+		return typeGeometry
+	case q.Type == "string" && q.Format == "date":
+		return typeDate
+	case q.Type == "string" && q.Format == "date-time":
+		return typeTimestamp
+	case q.Type == "string":
+		return typeString
+	case q.Type == "number" || q.Type == "integer":
+		return typeNumber
+	case q.Type == "boolean":
+		return typeBoolean
+	}
+	return typeUnknown
+}
+
+// propertyType returns the declared type of a property, if any
+func (l *cqlListener) propertyType(name string) string {
+	q, ok := l.queryable(name)
+	if !ok {
+		return typeUnknown
+	}
+	return q.scalarType()
+}
+
+func (l *cqlListener) queryable(name string) (Queryable, bool) {
+	//-- CQL property names can be quoted
+	q, ok := l.queryables[strings.Trim(name, "\"")]
+	return q, ok
+}
+
+// sqlProperty returns the SQL for the column of a property
+func (l *cqlListener) sqlProperty(name string) string {
+	if q, ok := l.queryable(name); ok && q.Column != "" {
+		return "\"" + strings.ReplaceAll(q.Column, "\"", "\"\"") + "\""
+	}
+	return quotedName(name)
+}
+
+// propertySRID returns the SRID of a geometry property
+func (l *cqlListener) propertySRID(ctx IPropertyNameContext) int {
+	if q, ok := l.queryable(getText(ctx)); ok && q.SRID != 0 {
+		return q.SRID
+	}
+	return l.sourceSRID
+}
+
+// isGeography reports whether a geometry property is held in a geography column
+func (l *cqlListener) isGeography(ctx IPropertyNameContext) bool {
+	q, ok := l.queryable(getText(ctx))
+	return ok && q.Geography
+}
+
+// PostGIS functions which have a geography version
+var geographyFunction = map[string]bool{
+	"ST_Intersects": true,
+	"ST_DWithin":    true,
+}
+
+// enterGeomPredicate sets the SRID literals in a spatial predicate are transformed to,
+// which is the SRID of the property they are compared with,
+// and whether they are compared as geography values
+func (l *cqlListener) enterGeomPredicate(operator string, geoms []IGeomExpressionContext) {
+	l.targetSRID = l.sourceSRID
+	l.targetGeography = false
+	for _, geom := range geoms {
+		if geom.PropertyName() != nil {
+			l.targetSRID = l.propertySRID(geom.PropertyName())
+			l.targetGeography = l.isGeography(geom.PropertyName()) && geographyFunction[toPostGISFunction(operator)]
+			return
+		}
+	}
+}
+
+func (l *cqlListener) EnterSpatialPredicate(ctx *SpatialPredicateContext) {
+	l.enterGeomPredicate(ctx.SpatialOperator().GetText(), ctx.AllGeomExpression())
+}
+
+func (l *cqlListener) EnterDistancePredicate(ctx *DistancePredicateContext) {
+	l.enterGeomPredicate(ctx.DistanceOperator().GetText(), ctx.AllGeomExpression())
+}
+
+// sqlGeography casts the SQL of a geometry literal to geography
+func sqlGeography(sql string) string {
+	return strings.TrimSuffix(sql, "::geometry") + "::geography"
+}
+
+// declaredType returns the declared type of a scalar expression
+// which is a property, or unknown for any other expression
+func (l *cqlListener) declaredType(ctx IScalarExpressionContext) string {
+	return l.propertyType(propertyNameOf(ctx))
+}
+
+// propertyNameOf returns the name of the property a scalar expression consists of, if any
+func propertyNameOf(ctx IScalarExpressionContext) string {
+	switch expr := ctx.(type) {
+	case *ScalarValContext:
+		if name, ok := expr.val.(*LiteralNameContext); ok {
+			return getText(name.PropertyName())
+		}
+	case *ScalarParenContext:
+		return propertyNameOf(expr.expr)
+	}
+	return ""
+}
+
+// JSON Schemas of GeoJSON geometries, by queryable format
+var geoJSONSchemaForFormat = map[string]string{
+	"geometry-point":              "https://geojson.org/schema/Point.json",
+	"geometry-linestring":         "https://geojson.org/schema/LineString.json",
+	"geometry-polygon":            "https://geojson.org/schema/Polygon.json",
+	"geometry-multipoint":         "https://geojson.org/schema/MultiPoint.json",
+	"geometry-multilinestring":    "https://geojson.org/schema/MultiLineString.json",
+	"geometry-multipolygon":       "https://geojson.org/schema/MultiPolygon.json",
+	"geometry-geometrycollection": "https://geojson.org/schema/GeometryCollection.json",
+	"geometry-any":                "https://geojson.org/schema/Geometry.json",
+}
+
+type queryablesSchema struct {
+	Schema     string                     `json:"$schema"`
+	ID         string                     `json:"$id,omitempty"`
+	Type       string                     `json:"type"`
+	Title      string                     `json:"title,omitempty"`
+	Properties map[string]queryableSchema `json:"properties"`
+}
+
+type queryableSchema struct {
+	Ref         string        `json:"$ref,omitempty"`
+	Title       string        `json:"title,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Type        string        `json:"type,omitempty"`
+	Format      string        `json:"format,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Role        string        `json:"x-ogc-role,omitempty"`
+}
+
+// QueryablesSchema returns the queryables document of a collection
+// (OGC API - Features - Part 3) as a JSON Schema.
+// id is the URI of the document, and title the title of the collection.
+// Geometry properties refer to the GeoJSON schema of their geometry type.
+// If there is a single geometry property and no property has the role "primary-geometry",
+// the geometry property is given that role.
+func QueryablesSchema(id string, title string, queryables Queryables) ([]byte, error) {
+	doc := queryablesSchema{
+		Schema:     "https://json-schema.org/draft/2020-12/schema",
+		ID:         id,
+		Type:       "object",
+		Title:      title,
+		Properties: make(map[string]queryableSchema, len(queryables)),
+	}
+	var geomNames []string
+	hasPrimaryGeom := false
+	for name, q := range queryables {
+		prop := queryableSchema{
+			Title:       q.Title,
+			Description: q.Description,
+			Enum:        q.Enum,
+			Role:        q.Role,
+		}
+		if q.scalarType() == typeGeometry {
+			prop.Ref = geoJSONSchemaForFormat[strings.ToLower(q.Format)]
+			if prop.Ref == "" {
+				prop.Ref = geoJSONSchemaForFormat["geometry-any"]
+			}
+			geomNames = append(geomNames, name)
+		} else {
+			prop.Type = q.Type
+			prop.Format = q.Format
+		}
+		hasPrimaryGeom = hasPrimaryGeom || q.Role == "primary-geometry"
+		doc.Properties[name] = prop
+	}
+	if len(geomNames) == 1 && !hasPrimaryGeom {
+		if prop := doc.Properties[geomNames[0]]; prop.Role == "" {
+			prop.Role = "primary-geometry"
+			doc.Properties[geomNames[0]] = prop
+		}
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// ParseQueryablesSchema reads the queryables of a collection from a queryables document
+// (a JSON Schema, as returned by QueryablesSchema).
+// Geometry properties are recognised by a $ref to a GeoJSON schema, or a "geometry-" format.
+// A type which is a list (e.g. ["string", "null"]) gives its first type other than "null".
+func ParseQueryablesSchema(data []byte) (Queryables, error) {
+	var doc struct {
+		Properties map[string]struct {
+			queryableSchema
+			Type interface{} `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	queryables := make(Queryables, len(doc.Properties))
+	for name, prop := range doc.Properties {
+		q := Queryable{
+			Format:      prop.Format,
+			Enum:        prop.Enum,
+			Title:       prop.Title,
+			Description: prop.Description,
+			Role:        prop.Role,
+		}
+		switch t := prop.Type.(type) {
+		case string:
+			q.Type = t
+		case []interface{}:
+			for _, v := range t {
+				if s, ok := v.(string); ok && s != "null" {
+					q.Type = s
+					break
+				}
+			}
+		}
+		for format, ref := range geoJSONSchemaForFormat {
+			if prop.Ref == ref {
+				q.Format = format
+			}
+		}
+		queryables[name] = q
+	}
+	return queryables, nil
+}
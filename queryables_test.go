@@ -0,0 +1,208 @@
+package cql2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var queryables = cql2.Queryables{
+	"name":    {Type: "string"},
+	"pop":     {Type: "integer"},
+	"area":    {Type: "number"},
+	"active":  {Type: "boolean"},
+	"founded": {Type: "string", Format: "date"},
+	"updated": {Type: "string", Format: "date-time"},
+	"geom":    {Format: "geometry-polygon"},
+	"kind":    {Type: "string", Enum: []interface{}{"city", "town"}},
+	"rank":    {Type: "integer", Enum: []interface{}{1, 2, 3}},
+}
+
+var _ = Describe("Queryables", func() {
+	DescribeTable("casts",
+		func(cqlStr string, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithQueryables(queryables))
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("date with string", "founded = '2020-01-01'", "\"founded\" = '2020-01-01'::date"),
+		Entry("date with timestamp", "founded < 2020-01-01T10:00:00Z", "\"founded\" < '2020-01-01T10:00:00Z'::timestamptz"),
+		Entry("timestamp with timestamp", "updated >= 2020-01-01T10:00:00Z", "\"updated\" >= '2020-01-01T10:00:00Z'::timestamptz"),
+		Entry("timestamp with date string", "'2020-01-01' < updated", "'2020-01-01'::timestamptz < \"updated\""),
+		Entry("timestamp with now", "updated < NOW()", "\"updated\" < 'NOW'::timestamptz"),
+		Entry("integer with string", "pop = '5'", "\"pop\" = '5'::numeric"),
+		Entry("number with number", "area > 1.5", "\"area\" > 1.5"),
+		Entry("quoted property", "\"pop\" <> '5'", "\"pop\" <> '5'::numeric"),
+		Entry("parenthesized property", "(pop) = '5'", "(\"pop\") = '5'::numeric"),
+		Entry("string with string", "name = '5'", "\"name\" = '5'"),
+		Entry("date and timestamp properties", "founded < updated", "\"founded\" < \"updated\""),
+		Entry("undeclared property", "other = '2020-01-01'", "\"other\" = '2020-01-01'"),
+		Entry("between dates", "founded BETWEEN '2020-01-01' AND 2021-01-01", "\"founded\" BETWEEN '2020-01-01'::date AND '2021-01-01'::date"),
+		Entry("in dates", "founded IN ('2020-01-01', 2021-01-01)", "\"founded\" IN ('2020-01-01'::date,'2021-01-01'::date)"),
+		Entry("between date and timestamp", "founded BETWEEN 2020-01-01 AND 2020-01-01T12:00:00Z",
+			"\"founded\" BETWEEN '2020-01-01'::date AND '2020-01-01T12:00:00Z'::timestamptz"),
+		Entry("in numbers", "pop NOT IN (1, '2')", "\"pop\" NOT IN (1,'2'::numeric)"),
+		Entry("literal in property list", "'5' IN (pop, area)", "'5'::numeric IN (\"pop\",\"area\")"),
+		Entry("literal in list of declared and undeclared properties", "'5' IN (pop, other)", "'5'::numeric IN (\"pop\",\"other\")"),
+	)
+
+	DescribeTable("type errors",
+		func(cqlStr string, column int) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithQueryables(queryables))
+			var typeErr *cql2.TypeError
+			Expect(errors.As(err, &typeErr)).To(BeTrue())
+			Expect(typeErr.Column).To(Equal(column))
+		},
+		Entry("geometry with string", "geom = 'abc'", 7),
+		Entry("string with geometry", "'abc' = geom", 8),
+		Entry("number with boolean", "pop = TRUE", 6),
+		Entry("string with number", "name > 5", 7),
+		Entry("invalid number string", "pop = 'five'", 6),
+		Entry("invalid date string", "founded = '2020-13-01'", 10),
+		Entry("invalid timestamp string", "updated > 'yesterday'", 10),
+		Entry("number with date", "founded = 5", 10),
+		Entry("boolean with date", "active BETWEEN founded AND updated", 15),
+		Entry("in list of strings for number", "pop IN (1, 'x')", 11),
+		Entry("properties of different types", "name = pop", 7),
+		Entry("in list of properties of different types", "'5' IN (name, pop)", 14),
+		Entry("in list of properties of different types reversed", "'5' IN (pop, name)", 13),
+	)
+
+	DescribeTable("schema validation",
+		func(cqlStr string, property string, text string) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithQueryables(queryables))
+			var typeErr *cql2.TypeError
+			Expect(errors.As(err, &typeErr)).To(BeTrue())
+			Expect(typeErr.Property).To(Equal(property))
+			Expect(typeErr.Text).To(Equal(text))
+			Expect(cqlStr[typeErr.Start : typeErr.Stop+1]).To(Equal(text))
+		},
+		Entry("like on number", "x = 1 AND pop LIKE '1%'", "pop", "pop"),
+		Entry("spatial predicate on string", "intersects(name, POINT(0 0))", "name", "name"),
+		Entry("distance predicate on date", "dwithin(geom, founded, 10)", "founded", "founded"),
+		Entry("enum string", "kind = 'village'", "kind", "'village'"),
+		Entry("enum string reversed", "'village' <> kind", "kind", "'village'"),
+		Entry("enum number", "rank = 4", "rank", "4"),
+		Entry("enum in list", "kind IN ('city', 'hamlet')", "kind", "'hamlet'"),
+		Entry("reversed between", "pop BETWEEN 100 AND 10", "pop", "pop BETWEEN 100 AND 10"),
+		Entry("reversed between timestamps", "updated BETWEEN 2021-01-01 AND 2020-12-31T23:00:00Z", "updated",
+			"updated BETWEEN 2021-01-01 AND 2020-12-31T23:00:00Z"),
+		Entry("reversed between historic dates", "founded BETWEEN 2000-01-01 AND 1000-01-01", "founded",
+			"founded BETWEEN 2000-01-01 AND 1000-01-01"),
+		Entry("non-boolean term", "name AND active", "name", "name"),
+		Entry("arithmetic on string", "name * 2 > 4", "name", "name"),
+		Entry("comparison with geometry", "geom = 'POINT(0 0)'", "geom", "'POINT(0 0)'"),
+		Entry("invalid date", "founded >= '2020-02-30'", "founded", "'2020-02-30'"),
+	)
+
+	DescribeTable("valid with schema",
+		func(cqlStr string) {
+			_, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithQueryables(queryables))
+			Expect(err).To(BeNil())
+		},
+		Entry("enum value", "kind = 'town' OR rank IN (1, 3)"),
+		Entry("enum ordering", "rank < 10"),
+		Entry("spatial predicate", "intersects(geom, POINT(0 0)) AND active"),
+		Entry("between", "pop BETWEEN 10 AND 10 AND founded BETWEEN 2020-01-01 AND 2020-12-31"),
+		Entry("not between reversed bounds", "pop NOT BETWEEN 100 AND 10"),
+		Entry("between historic dates", "founded BETWEEN 1000-01-01 AND 2000-01-01 AND updated BETWEEN 1600-01-01 AND 2300-01-01"),
+		Entry("between large integers", "pop BETWEEN 9007199254740992 AND 9007199254740993"),
+		Entry("arithmetic", "pop / area > 100"),
+		Entry("concatenation", "name || '!' = 'a!'"),
+	)
+
+	It("names the property and the span in the message", func() {
+		_, err := cql2.TranspileToSQL("kind = 'village'", 4326, 4326, cql2.WithQueryables(queryables))
+		Expect(err).To(MatchError("CQL type error at line 1, column 7 in \"'village'\": 'village' is not an allowed value of property kind"))
+	})
+
+	It("checks BETWEEN bounds of declared properties only", func() {
+		_, err := cql2.TranspileToSQL("x BETWEEN 5 AND 1", 4326, 4326)
+		Expect(err).To(BeNil())
+		_, err = cql2.TranspileToSQL("other BETWEEN 5 AND 1", 4326, 4326, cql2.WithQueryables(queryables))
+		Expect(err).To(BeNil())
+	})
+
+	It("checks enum values decoded as JSON numbers", func() {
+		q := cql2.Queryables{"rank": {Type: "integer", Enum: []interface{}{json.Number("1"), json.Number("2.0")}}}
+		_, err := cql2.TranspileToSQL("rank = 2", 4326, 4326, cql2.WithQueryables(q))
+		Expect(err).To(BeNil())
+		_, err = cql2.TranspileToSQL("rank = 3", 4326, 4326, cql2.WithQueryables(q))
+		var typeErr *cql2.TypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Queryables schema", func() {
+	It("generates the queryables document", func() {
+		schema, err := cql2.QueryablesSchema("https://example.com/collections/places/queryables", "Places", cql2.Queryables{
+			"id":      {Type: "integer", Role: "id"},
+			"name":    {Type: "string", Title: "Name", Description: "Name of the place"},
+			"kind":    {Type: "string", Enum: []interface{}{"city", "town"}},
+			"updated": {Type: "string", Format: "date-time", Role: "primary-instant"},
+			"geom":    {Format: "geometry-polygon"},
+		})
+		Expect(err).To(BeNil())
+		Expect(schema).To(MatchJSON(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"$id": "https://example.com/collections/places/queryables",
+			"type": "object",
+			"title": "Places",
+			"properties": {
+				"id": {"type": "integer", "x-ogc-role": "id"},
+				"name": {"type": "string", "title": "Name", "description": "Name of the place"},
+				"kind": {"type": "string", "enum": ["city", "town"]},
+				"updated": {"type": "string", "format": "date-time", "x-ogc-role": "primary-instant"},
+				"geom": {"$ref": "https://geojson.org/schema/Polygon.json", "x-ogc-role": "primary-geometry"}
+			}
+		}`))
+	})
+
+	It("keeps declared geometry roles", func() {
+		schema, err := cql2.QueryablesSchema("", "", cql2.Queryables{
+			"footprint": {Format: "geometry-multipolygon", Role: "primary-geometry"},
+			"centroid":  {Format: "geometry-point"},
+			"route":     {Format: "geometry-unknown"},
+		})
+		Expect(err).To(BeNil())
+		Expect(schema).To(MatchJSON(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"properties": {
+				"footprint": {"$ref": "https://geojson.org/schema/MultiPolygon.json", "x-ogc-role": "primary-geometry"},
+				"centroid": {"$ref": "https://geojson.org/schema/Point.json"},
+				"route": {"$ref": "https://geojson.org/schema/Geometry.json"}
+			}
+		}`))
+	})
+
+	It("reads the queryables document", func() {
+		queryables, err := cql2.ParseQueryablesSchema([]byte(`{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"properties": {
+				"id": {"type": "integer", "x-ogc-role": "id"},
+				"name": {"type": ["null", "string"], "title": "Name"},
+				"kind": {"type": "string", "enum": ["city", "town"]},
+				"updated": {"type": "string", "format": "date-time"},
+				"geom": {"$ref": "https://geojson.org/schema/Polygon.json", "x-ogc-role": "primary-geometry"},
+				"centroid": {"format": "geometry-point"}
+			}
+		}`))
+		Expect(err).To(BeNil())
+		Expect(queryables).To(Equal(cql2.Queryables{
+			"id":       {Type: "integer", Role: "id"},
+			"name":     {Type: "string", Title: "Name"},
+			"kind":     {Type: "string", Enum: []interface{}{"city", "town"}},
+			"updated":  {Type: "string", Format: "date-time"},
+			"geom":     {Format: "geometry-polygon", Role: "primary-geometry"},
+			"centroid": {Format: "geometry-point"},
+		}))
+	})
+})
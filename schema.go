@@ -0,0 +1,124 @@
+package cql2
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// checkPropertyType reports an error if a property is declared with a type other than the required one
+func (l *cqlListener) checkPropertyType(ctx IPropertyNameContext, required string, what string) {
+	name := getText(ctx)
+	if t := l.propertyType(name); t != typeUnknown && t != required {
+		l.propertyError(ctx, name, "property %s is %s, %s requires %s", name, t, what, required)
+	}
+}
+
+// checkEnum reports an error if a literal compared with a property
+// is not one of the values allowed for the property
+func (l *cqlListener) checkEnum(prop IScalarExpressionContext, value IScalarExpressionContext) {
+	name := propertyNameOf(prop)
+	q, ok := l.queryable(name)
+	if !ok || len(q.Enum) == 0 {
+		return
+	}
+	v, ok := literalValue(value)
+	if !ok {
+		return
+	}
+	for _, allowed := range q.Enum {
+		if sameValue(allowed, v) {
+			return
+		}
+	}
+	l.propertyError(value, name, "%s is not an allowed value of property %s", getText(value), name)
+}
+
+// literalValue returns the value of a string or numeric literal
+func literalValue(ctx IScalarExpressionContext) (interface{}, bool) {
+	val, ok := ctx.(*ScalarValContext)
+	if !ok {
+		return nil, false
+	}
+	switch lit := val.val.(type) {
+	case *LiteralStringContext:
+		text := getText(lit.CharacterLiteral())
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), true
+	case *LiteralNumericContext:
+		num, err := strconv.ParseFloat(getText(lit.NumericLiteral()), 64)
+		return num, err == nil
+	}
+	return nil, false
+}
+
+// sameValue compares an enum value with a literal value
+func sameValue(allowed interface{}, v interface{}) bool {
+	switch a := allowed.(type) {
+	case string:
+		s, ok := v.(string)
+		return ok && s == a
+	case float64:
+		return v == a
+	case float32:
+		return v == float64(a)
+	case int:
+		return v == float64(a)
+	case int64:
+		return v == float64(a)
+	case json.Number:
+		f, err := a.Float64()
+		return err == nil && v == f
+	}
+	return false
+}
+
+// checkBetweenBounds reports an error if the literal bounds of a BETWEEN predicate
+// on a declared property are reversed, since the predicate can then never be true.
+// NOT BETWEEN with reversed bounds is always true, which is allowed.
+func (l *cqlListener) checkBetweenBounds(ctx *IsBetweenPredicateContext) {
+	name := propertyNameOf(ctx.ScalarExpression(0))
+	if _, ok := l.queryable(name); !ok || ctx.NOT() != nil {
+		return
+	}
+	lower, lowerOk := boundValue(ctx.ScalarExpression(1))
+	upper, upperOk := boundValue(ctx.ScalarExpression(2))
+	if !lowerOk || !upperOk || !boundsReversed(lower, upper) {
+		return
+	}
+	l.propertyError(ctx, name, "BETWEEN bounds are reversed, %s is greater than %s",
+		getText(ctx.ScalarExpression(1)), getText(ctx.ScalarExpression(2)))
+}
+
+// boundValue returns the value of a numeric or temporal literal, as a *big.Rat or a time.Time
+func boundValue(ctx IScalarExpressionContext) (interface{}, bool) {
+	val, ok := ctx.(*ScalarValContext)
+	if !ok {
+		return nil, false
+	}
+	switch lit := val.val.(type) {
+	case *LiteralNumericContext:
+		return new(big.Rat).SetString(getText(lit.NumericLiteral()))
+	case *LiteralTemporalContext:
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, temporalValue(lit.TemporalLiteral())); err == nil {
+				return t, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// boundsReversed reports whether a lower bound of BETWEEN is greater than the upper bound
+func boundsReversed(lower interface{}, upper interface{}) bool {
+	switch l := lower.(type) {
+	case *big.Rat:
+		u, ok := upper.(*big.Rat)
+		return ok && l.Cmp(u) > 0
+	case time.Time:
+		u, ok := upper.(time.Time)
+		return ok && l.After(u)
+	}
+	return false
+}
@@ -0,0 +1,566 @@
+package cql2
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// mean radius of the earth in metres, for haversine distances
+const earthRadius = 6371008.8
+
+// location of a point relative to a geometry
+type location int
+
+const (
+	locExterior location = iota
+	locBoundary
+	locInterior
+)
+
+// shape is a geometry decomposed into its points, lines and polygons
+type shape struct {
+	points []orb.Point
+	lines  []orb.LineString
+	polys  []orb.Polygon
+	// coordinate magnitude, for the tolerance of point location
+	scale float64
+}
+
+func newShape(geom orb.Geometry) *shape {
+	s := &shape{scale: 1}
+	s.add(geom)
+	grow := func(p orb.Point) {
+		s.scale = math.Max(s.scale, math.Max(math.Abs(p[0]), math.Abs(p[1])))
+	}
+	for _, p := range s.points {
+		grow(p)
+	}
+	s.segments(func(a, b orb.Point) { grow(a) })
+	return s
+}
+
+func (s *shape) add(geom orb.Geometry) {
+	switch g := geom.(type) {
+	case orb.Point:
+		s.points = append(s.points, g)
+	case orb.MultiPoint:
+		s.points = append(s.points, g...)
+	case orb.LineString:
+		s.lines = append(s.lines, g)
+	case orb.MultiLineString:
+		s.lines = append(s.lines, g...)
+	case orb.Ring:
+		s.polys = append(s.polys, orb.Polygon{g})
+	case orb.Polygon:
+		s.polys = append(s.polys, g)
+	case orb.MultiPolygon:
+		s.polys = append(s.polys, g...)
+	case orb.Bound:
+		s.polys = append(s.polys, g.ToPolygon())
+	case orb.Collection:
+		for _, member := range g {
+			s.add(member)
+		}
+	}
+}
+
+// dim is the dimension of the shape: 0 for points, 1 for lines, 2 for polygons, -1 if empty
+func (s *shape) dim() int {
+	switch {
+	case len(s.polys) > 0:
+		return 2
+	case len(s.lines) > 0:
+		return 1
+	case len(s.points) > 0:
+		return 0
+	}
+	return -1
+}
+
+// segments calls fn for the segments of the lines and polygon rings
+func (s *shape) segments(fn func(a, b orb.Point)) {
+	for _, line := range s.lines {
+		for i := 1; i < len(line); i++ {
+			fn(line[i-1], line[i])
+		}
+	}
+	for _, poly := range s.polys {
+		for _, ring := range poly {
+			for i := 1; i < len(ring); i++ {
+				fn(ring[i-1], ring[i])
+			}
+			if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+				fn(ring[len(ring)-1], ring[0])
+			}
+		}
+	}
+}
+
+func (s *shape) tolerance() float64 {
+	return 1e-9 * s.scale
+}
+
+// locate returns the location of a point relative to the shape.
+// A point is interior if it is in the interior of any part.
+func (s *shape) locate(p orb.Point) location {
+	tol := s.tolerance()
+	loc := locExterior
+	for _, pt := range s.points {
+		if distance(p, pt) <= tol {
+			return locInterior
+		}
+	}
+	for _, line := range s.lines {
+		if len(line) == 0 {
+			continue
+		}
+		closed := line[0] == line[len(line)-1]
+		if !closed && (distance(p, line[0]) <= tol || distance(p, line[len(line)-1]) <= tol) {
+			loc = locBoundary
+			continue
+		}
+		for i := 1; i < len(line); i++ {
+			if segmentDistance(p, line[i-1], line[i]) <= tol {
+				return locInterior
+			}
+		}
+	}
+	for _, poly := range s.polys {
+		switch locateInPolygon(p, poly, tol) {
+		case locInterior:
+			return locInterior
+		case locBoundary:
+			loc = locBoundary
+		}
+	}
+	return loc
+}
+
+// locateInPolygon locates a point by the even-odd rule over all rings
+func locateInPolygon(p orb.Point, poly orb.Polygon, tol float64) location {
+	inside := false
+	for _, ring := range poly {
+		n := len(ring)
+		for i := 0; i < n; i++ {
+			a, b := ring[i], ring[(i+1)%n]
+			if segmentDistance(p, a, b) <= tol {
+				return locBoundary
+			}
+			if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < a[0]+(p[1]-a[1])*(b[0]-a[0])/(b[1]-a[1]) {
+				inside = !inside
+			}
+		}
+	}
+	if inside {
+		return locInterior
+	}
+	return locExterior
+}
+
+// interiorPoint returns a point in the interior of a polygon,
+// in the middle of the widest span of a horizontal line through it
+func interiorPoint(poly orb.Polygon) (orb.Point, bool) {
+	if len(poly) == 0 || len(poly[0]) < 3 {
+		return orb.Point{}, false
+	}
+	//-- a horizontal line between the lowest and highest vertices of the shell
+	b := poly[0].Bound()
+	y := (b.Min[1] + b.Max[1]) / 2
+	var xs []float64
+	for _, ring := range poly {
+		n := len(ring)
+		for i := 0; i < n; i++ {
+			a, c := ring[i], ring[(i+1)%n]
+			if (a[1] > y) != (c[1] > y) {
+				xs = append(xs, a[0]+(y-a[1])*(c[0]-a[0])/(c[1]-a[1]))
+			}
+		}
+	}
+	sort.Float64s(xs)
+	best, found := orb.Point{}, false
+	width := 0.0
+	for i := 0; i+1 < len(xs); i += 2 {
+		if w := xs[i+1] - xs[i]; w > width {
+			width, best, found = w, orb.Point{(xs[i] + xs[i+1]) / 2, y}, true
+		}
+	}
+	return best, found
+}
+
+// samplePoint is a point of a shape used to locate it relative to another
+type samplePoint struct {
+	orb.Point
+	// whether the point lies between the points where a segment meets the other shape
+	between bool
+}
+
+// samples returns points of shape a which together locate it relative to shape b:
+// its vertices, the points where its segments meet b, the midpoints between them,
+// and a point in the interior of each polygon.
+func (a *shape) samples(b *shape) []samplePoint {
+	var pts []samplePoint
+	for _, p := range a.points {
+		pts = append(pts, samplePoint{Point: p})
+	}
+	a.segments(func(p, q orb.Point) {
+		ts := []float64{0, 1}
+		b.segments(func(r, s orb.Point) {
+			ts = append(ts, segmentIntersections(p, q, r, s)...)
+		})
+		for _, pt := range b.points {
+			if t, ok := projection(pt, p, q); ok && distance(pt, lerp(p, q, t)) <= b.tolerance() {
+				ts = append(ts, t)
+			}
+		}
+		sort.Float64s(ts)
+		for i, t := range ts {
+			pts = append(pts, samplePoint{Point: lerp(p, q, t)})
+			if i > 0 && t > ts[i-1] {
+				pts = append(pts, samplePoint{Point: lerp(p, q, (t+ts[i-1])/2), between: true})
+			}
+		}
+	})
+	for _, poly := range a.polys {
+		if pt, ok := interiorPoint(poly); ok {
+			pts = append(pts, samplePoint{Point: pt})
+		}
+	}
+	return pts
+}
+
+// relation is a simplified DE-9IM intersection matrix of two shapes
+type relation struct {
+	dimA, dimB int
+	// cells[locA][locB] is set if the shapes have a point with these locations in common
+	cells [3][3]bool
+	// whether two lines share a segment, rather than only points
+	lineOverlap bool
+}
+
+func relate(geomA orb.Geometry, geomB orb.Geometry) *relation {
+	a, b := newShape(geomA), newShape(geomB)
+	r := &relation{dimA: a.dim(), dimB: b.dim()}
+	if r.dimA < 0 || r.dimB < 0 {
+		return r
+	}
+	for _, p := range a.samples(b) {
+		locA, locB := a.locate(p.Point), b.locate(p.Point)
+		r.cells[locA][locB] = true
+		//-- a part of a segment between the points where it meets a line lies on the line
+		if p.between && locB != locExterior && r.dimB == 1 {
+			r.lineOverlap = true
+		}
+	}
+	for _, p := range b.samples(a) {
+		r.cells[a.locate(p.Point)][b.locate(p.Point)] = true
+	}
+	r.infer()
+	return r
+}
+
+// infer completes the matrix with the cells implied by the samples.
+// The exterior of a shape and the interior of a polygon are open,
+// so the interior of a line or polygon next to its boundary shares their locations.
+func (r *relation) infer() {
+	for _, loc := range []location{locExterior, locInterior} {
+		if r.cells[locBoundary][loc] && (loc == locExterior || r.dimB == 2) {
+			r.cells[locInterior][loc] = true
+		}
+		if r.cells[loc][locBoundary] && (loc == locExterior || r.dimA == 2) {
+			r.cells[loc][locInterior] = true
+		}
+	}
+	//-- a polygon is never covered by a shape of lower dimension
+	if r.dimA == 2 && (r.dimB < 2 || r.cells[locInterior][locBoundary]) {
+		r.cells[locInterior][locExterior] = true
+	}
+	if r.dimB == 2 && (r.dimA < 2 || r.cells[locBoundary][locInterior]) {
+		r.cells[locExterior][locInterior] = true
+	}
+}
+
+func (r *relation) intersects() bool {
+	return r.cells[locInterior][locInterior] || r.cells[locInterior][locBoundary] ||
+		r.cells[locBoundary][locInterior] || r.cells[locBoundary][locBoundary]
+}
+
+func (r *relation) within() bool {
+	return r.cells[locInterior][locInterior] && !r.cells[locInterior][locExterior] && !r.cells[locBoundary][locExterior]
+}
+
+func (r *relation) contains() bool {
+	return r.cells[locInterior][locInterior] && !r.cells[locExterior][locInterior] && !r.cells[locExterior][locBoundary]
+}
+
+func (r *relation) equals() bool {
+	return r.within() && r.contains()
+}
+
+func (r *relation) touches() bool {
+	return r.intersects() && !r.cells[locInterior][locInterior]
+}
+
+func (r *relation) overlaps() bool {
+	if r.dimA != r.dimB || (r.dimA == 1 && !r.lineOverlap) {
+		return false
+	}
+	return r.cells[locInterior][locInterior] && r.cells[locInterior][locExterior] && r.cells[locExterior][locInterior]
+}
+
+func (r *relation) crosses() bool {
+	ii := r.cells[locInterior][locInterior]
+	switch {
+	case r.dimA < r.dimB:
+		return ii && r.cells[locInterior][locExterior]
+	case r.dimA > r.dimB:
+		return ii && r.cells[locExterior][locInterior]
+	case r.dimA == 1:
+		return ii && !r.lineOverlap
+	}
+	return false
+}
+
+// spatialPredicates evaluate the spatial operators on two non-empty geometries
+var spatialPredicates = map[string]func(r *relation) bool{
+	"s_intersects": (*relation).intersects,
+	"s_disjoint":   func(r *relation) bool { return !r.intersects() },
+	"s_within":     (*relation).within,
+	"s_contains":   (*relation).contains,
+	"s_equals":     (*relation).equals,
+	"s_touches":    (*relation).touches,
+	"s_overlaps":   (*relation).overlaps,
+	"s_crosses":    (*relation).crosses,
+}
+
+// lerp returns the point at parameter t of segment p-q
+func lerp(p, q orb.Point, t float64) orb.Point {
+	return orb.Point{p[0] + t*(q[0]-p[0]), p[1] + t*(q[1]-p[1])}
+}
+
+func distance(p, q orb.Point) float64 {
+	return math.Hypot(p[0]-q[0], p[1]-q[1])
+}
+
+// projection returns the parameter of the point of segment p-q closest to pt
+func projection(pt, p, q orb.Point) (float64, bool) {
+	dx, dy := q[0]-p[0], q[1]-p[1]
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return 0, false
+	}
+	t := ((pt[0]-p[0])*dx + (pt[1]-p[1])*dy) / l2
+	return math.Max(0, math.Min(1, t)), true
+}
+
+// segmentDistance is the planar distance from a point to segment a-b
+func segmentDistance(p, a, b orb.Point) float64 {
+	t, ok := projection(p, a, b)
+	if !ok {
+		return distance(p, a)
+	}
+	return distance(p, lerp(a, b, t))
+}
+
+// segmentIntersections returns the parameters along p-q of the points it shares with r-s:
+// the crossing point, or the ends of a collinear overlap
+func segmentIntersections(p, q, r, s orb.Point) []float64 {
+	d := orb.Point{q[0] - p[0], q[1] - p[1]}
+	e := orb.Point{s[0] - r[0], s[1] - r[1]}
+	denom := d[0]*e[1] - d[1]*e[0]
+	w := orb.Point{r[0] - p[0], r[1] - p[1]}
+	if denom != 0 {
+		t := (w[0]*e[1] - w[1]*e[0]) / denom
+		u := (w[0]*d[1] - w[1]*d[0]) / denom
+		if t >= 0 && t <= 1 && u >= 0 && u <= 1 {
+			return []float64{t}
+		}
+		return nil
+	}
+	//-- parallel segments share points only if collinear
+	if w[0]*d[1]-w[1]*d[0] != 0 {
+		return nil
+	}
+	var ts []float64
+	for _, pt := range []orb.Point{r, s} {
+		if t, ok := projection(pt, p, q); ok && distance(pt, lerp(p, q, t)) == 0 {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// distanceMetric measures the distance between points, and from a point to a segment
+type distanceMetric struct {
+	point   func(p, q orb.Point) float64
+	segment func(p, a, b orb.Point) float64
+}
+
+var planarMetric = distanceMetric{point: distance, segment: segmentDistance}
+
+var haversineMetric = distanceMetric{point: haversine, segment: haversineSegment}
+
+// geometryDistance returns the smallest distance between two geometries,
+// which is 0 if they intersect
+func geometryDistance(geomA orb.Geometry, geomB orb.Geometry, metric distanceMetric) float64 {
+	if relate(geomA, geomB).intersects() {
+		return 0
+	}
+	a, b := newShape(geomA), newShape(geomB)
+	best := math.Inf(1)
+	for _, p := range a.points {
+		best = math.Min(best, b.distanceTo(p, metric))
+	}
+	for _, p := range b.points {
+		best = math.Min(best, a.distanceTo(p, metric))
+	}
+	//-- segments which do not intersect are closest at one of their ends
+	a.segments(func(p, q orb.Point) {
+		best = math.Min(best, math.Min(b.distanceTo(p, metric), b.distanceTo(q, metric)))
+	})
+	b.segments(func(p, q orb.Point) {
+		best = math.Min(best, math.Min(a.distanceTo(p, metric), a.distanceTo(q, metric)))
+	})
+	return best
+}
+
+// distanceTo returns the smallest distance from a point to the points and segments of a shape
+func (s *shape) distanceTo(p orb.Point, metric distanceMetric) float64 {
+	best := math.Inf(1)
+	for _, pt := range s.points {
+		best = math.Min(best, metric.point(p, pt))
+	}
+	s.segments(func(a, b orb.Point) {
+		best = math.Min(best, metric.segment(p, a, b))
+	})
+	return best
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// haversine returns the great-circle distance in metres between two lon/lat points
+func haversine(p, q orb.Point) float64 {
+	return earthRadius * angularDistance(p, q)
+}
+
+func angularDistance(p, q orb.Point) float64 {
+	lat1, lat2 := radians(p[1]), radians(q[1])
+	dLat, dLon := lat2-lat1, radians(q[0]-p[0])
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func bearing(p, q orb.Point) float64 {
+	lat1, lat2 := radians(p[1]), radians(q[1])
+	dLon := radians(q[0] - p[0])
+	return math.Atan2(math.Sin(dLon)*math.Cos(lat2), math.Cos(lat1)*math.Sin(lat2)-math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLon))
+}
+
+// haversineSegment returns the great-circle distance in metres from a lon/lat point
+// to the great-circle arc a-b
+func haversineSegment(p, a, b orb.Point) float64 {
+	d13 := angularDistance(a, p)
+	d12 := angularDistance(a, b)
+	if d12 == 0 {
+		return earthRadius * d13
+	}
+	delta := bearing(a, p) - bearing(a, b)
+	//-- the point is behind the start of the arc
+	if math.Cos(delta) < 0 {
+		return earthRadius * d13
+	}
+	dxt := math.Asin(math.Sin(d13) * math.Sin(delta))
+	dat := math.Acos(math.Max(-1, math.Min(1, math.Cos(d13)/math.Cos(dxt))))
+	//-- the point is beyond the end of the arc
+	if dat > d12 {
+		return haversine(p, b)
+	}
+	return earthRadius * math.Abs(dxt)
+}
+
+// orbGeometry converts a geometry literal to an orb geometry.
+// Z and M values are dropped, and curved geometries are not supported.
+func orbGeometry(node Node) (orb.Geometry, error) {
+	switch n := node.(type) {
+	case *Envelope:
+		if len(n.Bounds) != 4 && len(n.Bounds) != 6 {
+			return nil, fmt.Errorf("invalid envelope")
+		}
+		half := len(n.Bounds) / 2
+		xmin, ymin, xmax, ymax := n.Bounds[0], n.Bounds[1], n.Bounds[half], n.Bounds[half+1]
+		//-- a box crossing the antimeridian is split on either side of it
+		if xmin > xmax {
+			return orb.Collection{
+				orb.Bound{Min: orb.Point{xmin, ymin}, Max: orb.Point{180, ymax}},
+				orb.Bound{Min: orb.Point{-180, ymin}, Max: orb.Point{xmax, ymax}},
+			}, nil
+		}
+		return orb.Bound{Min: orb.Point{xmin, ymin}, Max: orb.Point{xmax, ymax}}, nil
+	case *Geometry:
+		return orbGeometryOf(n)
+	}
+	return nil, fmt.Errorf("%T is not a geometry", node)
+}
+
+func orbGeometryOf(geom *Geometry) (orb.Geometry, error) {
+	points := func(coords [][]float64) []orb.Point {
+		pts := make([]orb.Point, len(coords))
+		for i, c := range coords {
+			if len(c) >= 2 {
+				pts[i] = orb.Point{c[0], c[1]}
+			}
+		}
+		return pts
+	}
+	switch geom.Type {
+	case "Point":
+		if len(geom.Coords) == 0 {
+			return orb.MultiPoint{}, nil
+		}
+		return points(geom.Coords)[0], nil
+	case "LineString":
+		return orb.LineString(points(geom.Coords)), nil
+	case "Polygon":
+		poly := orb.Polygon{}
+		for _, ring := range geom.Parts {
+			poly = append(poly, orb.Ring(points(ring.Coords)))
+		}
+		return poly, nil
+	case "MultiPoint":
+		mp := orb.MultiPoint{}
+		for _, part := range geom.Parts {
+			mp = append(mp, points(part.Coords)...)
+		}
+		return mp, nil
+	case "MultiLineString":
+		ml := orb.MultiLineString{}
+		for _, part := range geom.Parts {
+			ml = append(ml, orb.LineString(points(part.Coords)))
+		}
+		return ml, nil
+	}
+	//-- the remaining types consist of geometries
+	var parts []orb.Geometry
+	for _, part := range geom.Parts {
+		g, err := orbGeometryOf(part)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, g)
+	}
+	switch geom.Type {
+	case "MultiPolygon":
+		mp := orb.MultiPolygon{}
+		for _, part := range parts {
+			mp = append(mp, part.(orb.Polygon))
+		}
+		return mp, nil
+	case "GeometryCollection":
+		return orb.Collection(parts), nil
+	}
+	return nil, fmt.Errorf("%s cannot be evaluated in memory", wktGeometryTypes[geom.Type])
+}
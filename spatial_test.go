@@ -0,0 +1,111 @@
+package cql2_test
+
+import (
+	"github.com/paulmach/orb"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var square = orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+
+var _ = Describe("Spatial evaluation", func() {
+	DescribeTable("predicates",
+		func(cqlStr string, geom orb.Geometry, expected bool) {
+			filter, err := cql2.CompileText(cqlStr, cql2.WithGeometryProperty("geom"))
+			Expect(err).To(BeNil())
+			actual, err := filter(cql2.Feature{Geometry: geom})
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("point intersects polygon", "S_INTERSECTS(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", orb.Point{5, 5}, true),
+		Entry("point on boundary intersects", "intersects(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", orb.Point{10, 5}, true),
+		Entry("point outside", "intersects(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", orb.Point{15, 5}, false),
+		Entry("point in hole", "intersects(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0), (2 2, 8 2, 8 8, 2 8, 2 2)))", orb.Point{5, 5}, false),
+		Entry("disjoint", "disjoint(geom, POINT(20 20))", square, true),
+		Entry("within", "within(geom, ENVELOPE(-1, -1, 11, 11))", square, true),
+		Entry("within boundary", "within(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", orb.Point{10, 5}, false),
+		Entry("contains", "contains(geom, LINESTRING(1 1, 9 9))", square, true),
+		Entry("contains crossing line", "contains(geom, LINESTRING(1 1, 19 9))", square, false),
+		Entry("equals", "equals(geom, POLYGON((0 0, 0 10, 10 10, 10 0, 0 0)))", square, true),
+		Entry("touches", "touches(geom, POLYGON((10 0, 20 0, 20 10, 10 10, 10 0)))", square, true),
+		Entry("touches overlapping", "touches(geom, POLYGON((5 5, 15 5, 15 15, 5 15, 5 5)))", square, false),
+		Entry("overlaps", "overlaps(geom, POLYGON((5 5, 15 5, 15 15, 5 15, 5 5)))", square, true),
+		Entry("overlaps contained", "overlaps(geom, POLYGON((2 2, 8 2, 8 8, 2 8, 2 2)))", square, false),
+		Entry("overlapping lines", "overlaps(geom, LINESTRING(5 0, 15 0))", orb.LineString{{0, 0}, {10, 0}}, true),
+		Entry("crosses polygon", "crosses(geom, POLYGON((0 0, 10 0, 10 10, 0 10, 0 0)))", orb.LineString{{-5, 5}, {15, 5}}, true),
+		Entry("crossing lines", "crosses(geom, LINESTRING(0 10, 10 0))", orb.LineString{{0, 0}, {10, 10}}, true),
+		Entry("lines touching at ends", "crosses(geom, LINESTRING(10 0, 15 5))", orb.LineString{{0, 0}, {10, 0}}, false),
+		Entry("collection", "intersects(geom, GEOMETRYCOLLECTION(POINT(50 50), LINESTRING(-1 5, 1 5)))", square, true),
+		Entry("envelope crossing the antimeridian", "intersects(geom, ENVELOPE(170, -10, -170, 10))", orb.Point{-175, 0}, true),
+		Entry("GeoJSON literal", `intersects(geom, {"type": "Point", "coordinates": [5, 5]})`, square, true),
+		Entry("planar distance", "dwithin(geom, POINT(13 14), 5)", square, true),
+		Entry("planar distance too far", "dwithin(geom, POINT(13 14), 4.9)", square, false),
+		Entry("no geometry", "intersects(geom, POINT(0 0))", nil, false),
+	)
+
+	DescribeTable("haversine distance",
+		func(cqlStr string, geom orb.Geometry, expected bool) {
+			filter, err := cql2.CompileText(cqlStr, cql2.WithHaversineDistance(), cql2.WithGeometryProperty("geom"))
+			Expect(err).To(BeNil())
+			actual, err := filter(cql2.Feature{Geometry: geom})
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		},
+		//-- one degree of longitude at the equator is 111195 m
+		Entry("points", "dwithin(geom, POINT(1 0), 111200)", orb.Point{0, 0}, true),
+		Entry("points too far", "dwithin(geom, POINT(1 0), 111190)", orb.Point{0, 0}, false),
+		Entry("point and line", "dwithin(geom, LINESTRING(-1 1, 1 1), 111300)", orb.Point{0, 0}, true),
+		Entry("point beyond line end", "dwithin(geom, LINESTRING(1 0, 2 0), 111200)", orb.Point{0, 0}, true),
+		Entry("meridians converge", "dwithin(geom, POINT(1 60), 60000)", orb.Point{0, 60}, true),
+	)
+
+	It("evaluates geometry properties", func() {
+		filter, err := cql2.CompileText("intersects(footprint, POINT(5 5)) AND NOT intersects(geometry, POINT(5 5))")
+		Expect(err).To(BeNil())
+		actual, err := filter(cql2.Feature{
+			Properties: map[string]interface{}{"footprint": square},
+			Geometry:   orb.Point{0, 0},
+		})
+		Expect(err).To(BeNil())
+		Expect(actual).To(BeTrue())
+	})
+
+	DescribeTable("feature geometry",
+		func(cqlStr string, opts []cql2.CompileOption, expected bool) {
+			filter, err := cql2.CompileText(cqlStr, opts...)
+			Expect(err).To(BeNil())
+			actual, err := filter(cql2.Feature{Geometry: square})
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("default property", "intersects(geometry, POINT(5 5))", nil, true),
+		Entry("configured property", "intersects(shape, POINT(5 5))", []cql2.CompileOption{cql2.WithGeometryProperty("shape")}, true),
+		Entry("other property is NULL", "intersects(geom, POINT(5 5))", nil, false),
+		Entry("negated NULL", "NOT intersects(geom, POINT(5 5))", nil, false),
+		Entry("NULL is unknown", "intersects(geom, POINT(5 5)) IS UNKNOWN", nil, true),
+	)
+
+	DescribeTable("feature geometry is NULL",
+		func(cqlStr string, withGeometry bool, withoutGeometry bool) {
+			filter, err := cql2.CompileText(cqlStr)
+			Expect(err).To(BeNil())
+			actual, err := filter(cql2.Feature{Geometry: square})
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(withGeometry))
+			actual, err = filter(cql2.Feature{Properties: map[string]interface{}{"name": "x"}})
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(withoutGeometry))
+		},
+		Entry("is null", "geometry IS NULL", false, true),
+		Entry("is not null", "geometry IS NOT NULL", true, false),
+		Entry("other property", "geom IS NULL", true, true),
+	)
+
+	It("does not evaluate curves", func() {
+		_, err := cql2.CompileText("intersects(geom, CIRCULARSTRING(0 0, 1 1, 2 0))")
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,411 @@
+package cql2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// precedence of boolean operators when printing text, from the loosest
+const (
+	precOr = iota + 1
+	precAnd
+	precNot
+	precPredicate
+)
+
+// precedence of scalar operators when printing text, from the loosest
+var scalarPrec = map[string]int{
+	"||": 1,
+	"+":  2,
+	"-":  2,
+	"*":  3,
+	"/":  3,
+	"%":  3,
+	"^":  4,
+}
+
+// precedence of scalar values
+const precValue = 5
+
+// predicates which have a negated form, e.g. NOT LIKE and IS NOT NULL
+var negatablePredicates = map[string]bool{
+	"like":      true,
+	"ilike":     true,
+	"between":   true,
+	"in":        true,
+	"isNull":    true,
+	"isTrue":    true,
+	"isFalse":   true,
+	"isUnknown": true,
+}
+
+// functions with a keyword name
+var keywordFunctions = map[string]bool{
+	"casei":   true,
+	"accenti": true,
+}
+
+// WKT keywords of the geometry types
+var wktGeometryTypes = map[string]string{
+	"Point":              "POINT",
+	"LineString":         "LINESTRING",
+	"Polygon":            "POLYGON",
+	"MultiPoint":         "MULTIPOINT",
+	"MultiLineString":    "MULTILINESTRING",
+	"MultiPolygon":       "MULTIPOLYGON",
+	"GeometryCollection": "GEOMETRYCOLLECTION",
+	"CircularString":     "CIRCULARSTRING",
+	"CompoundCurve":      "COMPOUNDCURVE",
+	"CurvePolygon":       "CURVEPOLYGON",
+	"MultiCurve":         "MULTICURVE",
+	"MultiSurface":       "MULTISURFACE",
+}
+
+// CanonicalText parses CQL text and returns it in canonical form.
+// See FormatText.
+func CanonicalText(cqlStr string) (string, error) {
+	node, err := Parse(cqlStr)
+	if err != nil || node == nil {
+		return "", err
+	}
+	return FormatText(node), nil
+}
+
+// FormatText renders a syntax tree as canonical CQL2 text:
+// keywords are upper case, spatial operators have the S_ prefix,
+// temporal literals use TIMESTAMP() and DATE(), geometries are WKT,
+// numbers are in shortest form, and only the parentheses needed by precedence are kept.
+// Parsing the text returns an identical tree.
+func FormatText(node Node) string {
+	var sb strings.Builder
+	writeBool(&sb, node, 0)
+	return sb.String()
+}
+
+func boolPrec(node Node) int {
+	op, ok := node.(*Op)
+	if !ok {
+		return precPredicate
+	}
+	switch op.Name {
+	case "or":
+		return precOr
+	case "and":
+		return precAnd
+	case "not":
+		if len(op.Args) == 1 && isNegatable(op.Args[0]) {
+			return precPredicate
+		}
+		return precNot
+	}
+	return precPredicate
+}
+
+func isNegatable(node Node) bool {
+	op, ok := node.(*Op)
+	return ok && negatablePredicates[op.Name]
+}
+
+// isTruthTest reports whether a node tests a truth value: IS [NOT] TRUE, FALSE or UNKNOWN
+func isTruthTest(node Node) bool {
+	op, ok := node.(*Op)
+	if ok && op.Name == "not" && len(op.Args) == 1 {
+		op, ok = op.Args[0].(*Op)
+	}
+	return ok && (op.Name == "isTrue" || op.Name == "isFalse" || op.Name == "isUnknown")
+}
+
+func writeBool(sb *strings.Builder, node Node, minPrec int) {
+	prec := boolPrec(node)
+	if prec < minPrec {
+		sb.WriteString("(")
+		defer sb.WriteString(")")
+	}
+	op, ok := node.(*Op)
+	if !ok {
+		writeScalar(sb, node, 0)
+		return
+	}
+	switch op.Name {
+	case "and", "or":
+		for i, arg := range op.Args {
+			if i > 0 {
+				sb.WriteString(" " + strings.ToUpper(op.Name) + " ")
+			}
+			writeBool(sb, arg, prec+1)
+		}
+	case "not":
+		if prec == precPredicate {
+			writePredicate(sb, op.Args[0].(*Op), true)
+			return
+		}
+		sb.WriteString("NOT ")
+		writeBool(sb, op.Args[0], precNot)
+	default:
+		writePredicate(sb, op, false)
+	}
+}
+
+func writePredicate(sb *strings.Builder, op *Op, not bool) {
+	notText := ""
+	if not {
+		notText = "NOT "
+	}
+	switch op.Name {
+	case "like", "ilike":
+		writeScalar(sb, op.Args[0], 0)
+		sb.WriteString(" " + notText + strings.ToUpper(op.Name) + " ")
+		writeScalar(sb, op.Args[1], 0)
+	case "between":
+		writeScalar(sb, op.Args[0], 0)
+		sb.WriteString(" " + notText + "BETWEEN ")
+		writeScalar(sb, op.Args[1], 0)
+		sb.WriteString(" AND ")
+		writeScalar(sb, op.Args[2], 0)
+	case "in":
+		writeScalar(sb, op.Args[0], 0)
+		sb.WriteString(" " + notText + "IN (")
+		writeList(sb, op.Args[1:])
+		sb.WriteString(")")
+	case "isNull":
+		writeScalar(sb, op.Args[0], 0)
+		sb.WriteString(" IS " + notText + "NULL")
+	case "isTrue", "isFalse", "isUnknown":
+		//-- a truth value test can only follow a parenthesized truth value test
+		if isTruthTest(op.Args[0]) {
+			sb.WriteString("(")
+			writeBool(sb, op.Args[0], 0)
+			sb.WriteString(")")
+		} else {
+			writeBool(sb, op.Args[0], precPredicate)
+		}
+		sb.WriteString(" IS " + notText + strings.ToUpper(strings.TrimPrefix(op.Name, "is")))
+	case "=", "<>", "<", ">", "<=", ">=":
+		writeScalar(sb, op.Args[0], 0)
+		sb.WriteString(" " + op.Name + " ")
+		writeScalar(sb, op.Args[1], 0)
+	default:
+		if _, isArithmetic := scalarPrec[op.Name]; isArithmetic {
+			writeScalar(sb, op, 0)
+			return
+		}
+		//-- spatial and distance operators
+		sb.WriteString(strings.ToUpper(op.Name) + "(")
+		writeList(sb, op.Args)
+		sb.WriteString(")")
+	}
+}
+
+func writeList(sb *strings.Builder, nodes []Node) {
+	for i, node := range nodes {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		writeScalar(sb, node, 0)
+	}
+}
+
+func writeScalar(sb *strings.Builder, node Node, minPrec int) {
+	switch n := node.(type) {
+	case *Op:
+		prec, isArithmetic := scalarPrec[n.Name]
+		if !isArithmetic {
+			writeBool(sb, n, 0)
+			return
+		}
+		if prec < minPrec {
+			sb.WriteString("(")
+			defer sb.WriteString(")")
+		}
+		//-- operators are left-associative
+		writeScalar(sb, n.Args[0], prec)
+		sb.WriteString(" " + n.Name + " ")
+		writeScalar(sb, n.Args[1], prec+1)
+	case *Call:
+		if keywordFunctions[n.Name] {
+			sb.WriteString(strings.ToUpper(n.Name))
+		} else {
+			sb.WriteString(n.Name)
+		}
+		sb.WriteString("(")
+		writeList(sb, n.Args)
+		sb.WriteString(")")
+	case *Property:
+		sb.WriteString(formatPropertyName(n.Name))
+	case *StringLit:
+		sb.WriteString("'" + strings.ReplaceAll(n.Value, "'", "''") + "'")
+	case *NumberLit:
+		if n.Text != "" {
+			sb.WriteString(n.Text)
+		} else {
+			sb.WriteString(formatNumber(n.Value))
+		}
+	case *BoolLit:
+		if n.Value {
+			sb.WriteString("TRUE")
+		} else {
+			sb.WriteString("FALSE")
+		}
+	case *TimestampLit:
+		if n.Now {
+			sb.WriteString("NOW()")
+		} else {
+			sb.WriteString("TIMESTAMP('" + n.Value + "')")
+		}
+	case *DateLit:
+		sb.WriteString("DATE('" + n.Value + "')")
+	case *Envelope:
+		writeSRID(sb, n.SRID)
+		sb.WriteString("BBOX(")
+		for i, v := range n.Bounds {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(formatNumber(v))
+		}
+		sb.WriteString(")")
+	case *Geometry:
+		writeSRID(sb, n.SRID)
+		writeWKT(sb, n)
+	}
+}
+
+func writeSRID(sb *strings.Builder, srid int) {
+	if srid != 0 {
+		sb.WriteString("SRID=" + strconv.Itoa(srid) + ";")
+	}
+}
+
+// writeWKT writes a geometry as WKT, without SRID
+func writeWKT(sb *strings.Builder, geom *Geometry) {
+	sb.WriteString(wktGeometryTypes[geom.Type])
+	if geom.Dim != "" {
+		sb.WriteString(" " + geom.Dim)
+	}
+	if len(geom.Coords) == 0 && len(geom.Parts) == 0 {
+		sb.WriteString(" EMPTY")
+		return
+	}
+	writeWKTBody(sb, geom)
+}
+
+// writeWKTBody writes the parenthesized coordinates of a geometry
+func writeWKTBody(sb *strings.Builder, geom *Geometry) {
+	sb.WriteString("(")
+	if geom.Coords != nil {
+		for i, coord := range geom.Coords {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			for j, v := range coord {
+				if j > 0 {
+					sb.WriteString(" ")
+				}
+				sb.WriteString(formatNumber(v))
+			}
+		}
+	}
+	for i, part := range geom.Parts {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		switch {
+		case geom.Type == "GeometryCollection":
+			writeWKT(sb, part)
+		case part.Type == "Point" && geom.Type == "MultiPoint",
+			part.Type == "LineString" && geom.Type != "GeometryCollection",
+			part.Type == "Polygon" && (geom.Type == "MultiPolygon" || geom.Type == "MultiSurface"):
+			//-- plain members of multi geometries and rings have no type keyword
+			writeWKTBody(sb, part)
+		default:
+			writeWKT(sb, part)
+		}
+	}
+	sb.WriteString(")")
+}
+
+// formatNumber returns the shortest text of a number which parses to the same value.
+// Exponent notation is used only for large or small positive numbers,
+// since CQL does not allow it for negative numbers.
+func formatNumber(v float64) string {
+	abs := math.Abs(v)
+	if v > 0 && (abs >= 1e21 || abs < 1e-6) {
+		return strconv.FormatFloat(v, 'e', -1, 64)
+	}
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatNumberText returns the canonical text of a numeric literal, in the form of formatNumber.
+// It works on the decimal digits rather than a float64,
+// so that numbers such as large integers keep their exact value.
+func formatNumberText(text string) string {
+	neg := strings.HasPrefix(text, "-")
+	text = strings.TrimLeft(text, "+-")
+	exp := 0
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		exp, _ = strconv.Atoi(text[i+1:])
+		text = text[:i]
+	}
+	whole, frac, _ := strings.Cut(text, ".")
+	// the value is digits * 10^exp
+	digits := strings.TrimLeft(whole+frac, "0")
+	exp -= len(frac)
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	digits = trimmed
+	if digits == "" {
+		return "0"
+	}
+	if e := len(digits) - 1 + exp; !neg && (e >= 21 || e < -6) {
+		mantissa := digits[:1]
+		if len(digits) > 1 {
+			mantissa += "." + digits[1:]
+		}
+		sign := "+"
+		if e < 0 {
+			sign, e = "-", -e
+		}
+		return fmt.Sprintf("%se%s%02d", mantissa, sign, e)
+	}
+	var s string
+	switch point := len(digits) + exp; {
+	case exp >= 0:
+		s = digits + strings.Repeat("0", exp)
+	case point > 0:
+		s = digits[:point] + "." + digits[point:]
+	default:
+		s = "0." + strings.Repeat("0", -point) + digits
+	}
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// formatPropertyName quotes a property name unless it is a plain identifier
+func formatPropertyName(name string) string {
+	if isIdentifier(name) {
+		return name
+	}
+	return "\"" + name + "\""
+}
+
+// isPropertyName reports whether a property name can be written in CQL2 text, quoted or not
+func isPropertyName(name string) bool {
+	return isIdentifier(name) || isIdentifier("\""+name+"\"")
+}
+
+// isIdentifier reports whether a text is a single identifier token
+func isIdentifier(text string) bool {
+	lexer := NewCqlLexer(antlr.NewInputStream(text))
+	lexer.RemoveErrorListeners()
+	tokens := lexer.GetAllTokens()
+	return len(tokens) == 1 && tokens[0].GetTokenType() == CqlLexerIdentifier
+}
@@ -0,0 +1,127 @@
+package cql2_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("CQL2 text", func() {
+	DescribeTable("canonical text",
+		func(cqlStr string, text string) {
+			actual, err := cql2.CanonicalText(cqlStr)
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(text))
+
+			//-- printing the parsed canonical text is stable
+			again, err := cql2.CanonicalText(actual)
+			Expect(err).To(BeNil())
+			Expect(again).To(Equal(text))
+
+			//-- and parses to the same tree
+			original, _ := cql2.Parse(cqlStr)
+			reparsed, err := cql2.Parse(actual)
+			Expect(err).To(BeNil())
+			Expect(reparsed).To(Equal(original))
+		},
+		Entry("keywords", "a=1 and not b<>2 or c is null", "a = 1 AND NOT b <> 2 OR c IS NULL"),
+		Entry("NOT binds before AND", "NOT a AND b", "NOT a AND b"),
+		Entry("NOT of a group", "NOT (a OR b)", "NOT (a OR b)"),
+		Entry("AND binds before OR", "(a AND b) OR c", "a AND b OR c"),
+		Entry("OR in AND", "(a OR b) AND (c OR d)", "(a OR b) AND (c OR d)"),
+		Entry("redundant parentheses", "((a = 1)) AND ((b))", "a = 1 AND b"),
+		Entry("multiplication before addition", "x = 2 * (3 + y)", "x = 2 * (3 + y)"),
+		Entry("redundant arithmetic parentheses", "x = (2 * 3) + (y)", "x = 2 * 3 + y"),
+		Entry("left-associative subtraction", "x - (y - z) = (x - y) - z", "x - (y - z) = x - y - z"),
+		Entry("concatenation", "a || ('b' || c) = 'abc'", "a || ('b' || c) = 'abc'"),
+		Entry("numbers", "x IN (1.50, 007, 0.0000001, 1E30, -0.25)", "x IN (1.5, 7, 1e-07, 1e+30, -0.25)"),
+		Entry("exact numbers", "id = 9007199254740993 OR x IN (12300, 0.00012, 1.5E-9, 2.5E21, -0.50, 00.0)",
+			"id = 9007199254740993 OR x IN (12300, 0.00012, 1.5e-09, 2.5e+21, -0.5, 0)"),
+		Entry("strings", "name LIKE 'O''Brien%'", "name LIKE 'O''Brien%'"),
+		Entry("negated predicates", "a not like 'x' and b not between 1 and 2 and c not in (1,2) and d is not null",
+			"a NOT LIKE 'x' AND b NOT BETWEEN 1 AND 2 AND c NOT IN (1, 2) AND d IS NOT NULL"),
+		Entry("truth values", "flag is not false and x = 1 is unknown", "flag IS NOT FALSE AND x = 1 IS UNKNOWN"),
+		Entry("truth value of a group", "(a = 1 or b = 2) is true and not (c) is not unknown",
+			"(a = 1 OR b = 2) IS TRUE AND NOT c IS NOT UNKNOWN"),
+		Entry("truth value of a truth value", "(flag is true) is not false", "(flag IS TRUE) IS NOT FALSE"),
+		Entry("unknown as property name", "unknown = 1 AND \"unknown\" IS UNKNOWN", "\"unknown\" = 1 AND \"unknown\" IS UNKNOWN"),
+		Entry("quoted properties", "\"name\" = 'x' AND \"and\" = 1", "name = 'x' AND \"and\" = 1"),
+		Entry("functions", "casei(name) = CaseI('x') AND AccentI(name) = 'X'", "CASEI(name) = CASEI('x') AND ACCENTI(name) = 'X'"),
+		Entry("timestamps", "t > 2020-01-01T10:00:00Z AND t < timestamp('2021-01-01T00:00:00Z') AND t <= now()",
+			"t > TIMESTAMP('2020-01-01T10:00:00Z') AND t < TIMESTAMP('2021-01-01T00:00:00Z') AND t <= NOW()"),
+		Entry("dates", "d = 2020-01-01 OR d = date('2021-01-01')", "d = DATE('2020-01-01') OR d = DATE('2021-01-01')"),
+		Entry("spatial operators", "intersects(geom, POINT(0 0)) AND s_within(geom, polygon((0 0,1 0,1 1,0 0)))",
+			"S_INTERSECTS(geom, POINT(0 0)) AND S_WITHIN(geom, POLYGON((0 0, 1 0, 1 1, 0 0)))"),
+		Entry("distance", "dwithin(geom, POINT(1 2), 1000.0)", "DWITHIN(geom, POINT(1 2), 1000)"),
+		Entry("envelope", "intersects(geom, SRID=3857;ENVELOPE(0,0,1,1))", "S_INTERSECTS(geom, SRID=3857;BBOX(0, 0, 1, 1))"),
+		Entry("dimensions", "intersects(geom, LINESTRING z(1 2 3,4 5 6))", "S_INTERSECTS(geom, LINESTRING Z(1 2 3, 4 5 6))"),
+		Entry("empty", "intersects(geom, point empty)", "S_INTERSECTS(geom, POINT EMPTY)"),
+		Entry("multi geometries", "intersects(geom, MULTIPOLYGON(((0 0,1 1,1 0,0 0)),((5 5,6 6,6 5,5 5))))",
+			"S_INTERSECTS(geom, MULTIPOLYGON(((0 0, 1 1, 1 0, 0 0)), ((5 5, 6 6, 6 5, 5 5))))"),
+		Entry("collection", "intersects(geom, GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(1 2,3 4)))",
+			"S_INTERSECTS(geom, GEOMETRYCOLLECTION(POINT(1 2), LINESTRING(1 2, 3 4)))"),
+		Entry("curves", "intersects(geom, CURVEPOLYGON(CIRCULARSTRING(0 0,4 0,4 4,0 4,0 0),(1 1,3 3,3 1,1 1)))",
+			"S_INTERSECTS(geom, CURVEPOLYGON(CIRCULARSTRING(0 0, 4 0, 4 4, 0 4, 0 0), (1 1, 3 3, 3 1, 1 1)))"),
+		Entry("multicurve", "intersects(geom, multicurve((0 0,1 1),compoundcurve(circularstring(0 0,1 1,1 0),(1 0,0 1))))",
+			"S_INTERSECTS(geom, MULTICURVE((0 0, 1 1), COMPOUNDCURVE(CIRCULARSTRING(0 0, 1 1, 1 0), (1 0, 0 1))))"),
+		Entry("keyword as property name", "empty = 1", "\"empty\" = 1"),
+		Entry("bbox as property name", "bbox = 1 AND intersects(bbox, bbox(0, 0, 1, 1))",
+			"\"bbox\" = 1 AND S_INTERSECTS(\"bbox\", BBOX(0, 0, 1, 1))"),
+		Entry("GeoJSON", `intersects(geom, {"type":"MultiPoint","coordinates":[[1,2],[3,4]]})`,
+			"S_INTERSECTS(geom, MULTIPOINT((1 2), (3 4)))"),
+		Entry("EWKB", "intersects(geom, 0101000020E6100000000000000000F03F0000000000000040)",
+			"S_INTERSECTS(geom, SRID=4326;POINT(1 2))"),
+		Entry("big-endian WKB of digits", "intersects(geom, 000000000100000000000000000000000000000000)",
+			"S_INTERSECTS(geom, POINT(0 0))"),
+	)
+
+	It("nests operators by precedence", func() {
+		node, err := cql2.Parse("NOT a AND b OR c")
+		Expect(err).To(BeNil())
+		Expect(node).To(Equal(&cql2.Op{Name: "or", Args: []cql2.Node{
+			&cql2.Op{Name: "and", Args: []cql2.Node{
+				&cql2.Op{Name: "not", Args: []cql2.Node{&cql2.Property{Name: "a"}}},
+				&cql2.Property{Name: "b"},
+			}},
+			&cql2.Property{Name: "c"},
+		}}))
+	})
+
+	DescribeTable("rejects what cannot be translated",
+		func(cqlStr string, column int) {
+			_, err := cql2.Parse(cqlStr)
+			var syntaxErr *cql2.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+			Expect(syntaxErr.Column).To(Equal(column))
+		},
+		Entry("unknown function", "a = 1 AND upper(name) = 'X'", 10),
+		Entry("wrong function arguments", "casei(name, 'x') = 'x'", 0),
+		Entry("invalid geometry dimension", "intersects(geom, POINT Q (0 0 0))", 23),
+		Entry("number out of range", "x = 1e400", 4),
+	)
+
+	It("gives an instant without time the same meaning as DATE", func() {
+		node, err := cql2.Parse("d = 2020-01-01")
+		Expect(err).To(BeNil())
+		Expect(node.(*cql2.Op).Args[1]).To(Equal(&cql2.DateLit{Value: "2020-01-01"}))
+		bare, err := cql2.TranspileToSQL("d = 2020-01-01", 4326, 4326)
+		Expect(err).To(BeNil())
+		date, err := cql2.TranspileToSQL("d = DATE('2020-01-01')", 4326, 4326)
+		Expect(err).To(BeNil())
+		Expect(bare).To(Equal(date))
+	})
+
+	It("accepts declared functions", func() {
+		node, err := cql2.Parse("Upper(name) = 'X'", cql2.WithFunction("upper", cql2.Function{SQLName: "upper", NumArgs: 1}))
+		Expect(err).To(BeNil())
+		Expect(cql2.FormatText(node)).To(Equal("upper(name) = 'X'"))
+	})
+
+	It("reports invalid WKB", func() {
+		_, err := cql2.Parse("intersects(geom, 0101000000000000000000F03F)")
+		Expect(err).To(MatchError(ContainSubstring("invalid WKB")))
+	})
+})
@@ -0,0 +1,226 @@
+package cql2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// CQL value types
+const (
+	typeUnknown   = ""
+	typeString    = "string"
+	typeNumber    = "number"
+	typeBoolean   = "boolean"
+	typeTimestamp = "timestamp"
+	typeDate      = "date"
+	typeGeometry  = "geometry"
+)
+
+// TypeError reports an expression whose value types are incompatible,
+// or which does not satisfy the declared queryables.
+// Line and Column give the position of the offending expression in the CQL text
+// (line is 1-based, column is 0-based, as reported by the parser).
+// Start and Stop are the offsets of its first and last character,
+// and Text is the expression itself.
+// Property is the name of the property concerned, if any.
+type TypeError struct {
+	Line     int
+	Column   int
+	Start    int
+	Stop     int
+	Text     string
+	Property string
+	Msg      string
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("CQL type error at line %d, column %d in %q: %s", e.Line, e.Column, e.Text, e.Msg)
+}
+
+func (l *cqlListener) typeError(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	l.propertyError(ctx, "", format, args...)
+}
+
+func (l *cqlListener) propertyError(ctx antlr.ParserRuleContext, property string, format string, args ...interface{}) {
+	start := ctx.GetStart()
+	stop := ctx.GetStop()
+	l.setError(&TypeError{
+		Line:     start.GetLine(),
+		Column:   start.GetColumn(),
+		Start:    start.GetStart(),
+		Stop:     stop.GetStop(),
+		Text:     start.GetInputStream().GetText(start.GetStart(), stop.GetStop()),
+		Property: property,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+// scalarType determines the type of a scalar expression from its literals
+// and declared properties.
+// The type of an undeclared property is not known, so it is compatible with any type.
+func (l *cqlListener) scalarType(ctx IScalarExpressionContext) string {
+	switch expr := ctx.(type) {
+	case *ScalarValContext:
+		switch val := expr.val.(type) {
+		case *LiteralNameContext:
+			return l.propertyType(getText(val.PropertyName()))
+		case *LiteralStringContext:
+			return typeString
+		case *LiteralNumericContext:
+			return typeNumber
+		case *LiteralBooleanContext:
+			return typeBoolean
+		case *LiteralTemporalContext:
+			if isDateLiteral(val.TemporalLiteral()) {
+				return typeDate
+			}
+			return typeTimestamp
+		case *FunctionValueContext:
+			name := getNodeText(val.Function().Identifier())
+			return l.functions[strings.ToLower(name)].ResultType
+		}
+	case *ScalarParenContext:
+		return l.scalarType(expr.expr)
+	case *ScalarExprContext:
+		if expr.op.GetText() == "||" {
+			return typeString
+		}
+		return typeNumber
+	}
+	return typeUnknown
+}
+
+// checkStringOperands reports an error if an expression has a known non-string type
+func (l *cqlListener) checkStringOperands(exprs []IScalarExpressionContext, what string) {
+	for _, expr := range exprs {
+		t := l.scalarType(expr)
+		if t == typeUnknown || t == typeString {
+			continue
+		}
+		if name := propertyNameOf(expr); name != "" {
+			l.propertyError(expr, name, "property %s is %s, %s requires string operands", name, t, what)
+		} else {
+			l.typeError(expr, "%s requires string operands, not %s", what, t)
+		}
+		return
+	}
+}
+
+// checkSameType reports an error if expressions have different known types
+func (l *cqlListener) checkSameType(exprs []IScalarExpressionContext, what string) {
+	firstType := typeUnknown
+	for _, expr := range exprs {
+		t := l.scalarType(expr)
+		if t == typeUnknown {
+			continue
+		}
+		if firstType == typeUnknown {
+			firstType = t
+		} else if !comparableTypes(firstType, t) {
+			l.typeError(expr, "%s mixes %s and %s values", what, firstType, t)
+			return
+		}
+	}
+}
+
+// comparableTypes reports whether values of two types can be compared
+func comparableTypes(t1 string, t2 string) bool {
+	if t1 == typeUnknown || t2 == typeUnknown || t1 == t2 {
+		return true
+	}
+	return isTemporalType(t1) && isTemporalType(t2)
+}
+
+func isTemporalType(t string) bool {
+	return t == typeDate || t == typeTimestamp
+}
+
+// comparedSQL returns the SQL for the operands of a comparison.
+// A literal compared with a declared property is cast to the type of the property.
+// An error is reported if the operand types cannot be compared.
+func (l *cqlListener) comparedSQL(left IScalarExpressionContext, right IScalarExpressionContext) (string, string) {
+	leftSQL, leftType := l.typedOperand(left, propertyNameOf(right))
+	rightSQL, rightType := l.typedOperand(right, propertyNameOf(left))
+	if comparableTypes(leftType, rightType) {
+		return leftSQL, rightSQL
+	}
+	if name := propertyNameOf(left); name != "" {
+		l.propertyError(right, name, "cannot compare property %s of type %s with %s", name, leftType, rightType)
+	} else if name := propertyNameOf(right); name != "" {
+		l.propertyError(right, name, "cannot compare %s with property %s of type %s", leftType, name, rightType)
+	} else {
+		l.typeError(right, "cannot compare %s with %s", leftType, rightType)
+	}
+	return leftSQL, rightSQL
+}
+
+// typedOperand returns the SQL and type of an operand compared with a property.
+// String and temporal literals are cast explicitly to a declared numeric or temporal type,
+// to avoid relying on implicit casts in Postgres.
+// A timestamp with a time of day compared with a date property is cast to a timestamp instead.
+func (l *cqlListener) typedOperand(ctx IScalarExpressionContext, property string) (string, string) {
+	t := l.scalarType(ctx)
+	target := l.propertyType(property)
+	val, ok := ctx.(*ScalarValContext)
+	if !ok {
+		return sqlFor(ctx), t
+	}
+	var text, value, sql string
+	switch lit := val.val.(type) {
+	case *LiteralStringContext:
+		text = quotedText(getText(lit.CharacterLiteral()))
+		value = strings.ReplaceAll(text[1:len(text)-1], "''", "'")
+		sql = l.sqlLiteral(lit.CharacterLiteral(), text, value)
+	case *LiteralTemporalContext:
+		value = temporalValue(lit.TemporalLiteral())
+		text = "'" + value + "'"
+		sql = l.sqlLiteral(lit.TemporalLiteral(), text, value)
+	default:
+		return sqlFor(ctx), t
+	}
+	var valid bool
+	var cast string
+	switch target {
+	case typeNumber:
+		if t != typeString {
+			return sqlFor(ctx), t
+		}
+		_, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		valid = err == nil
+		cast = "::numeric"
+	case typeDate:
+		isDate := isTimeValue(value, dateLayouts)
+		if t == typeTimestamp && !isDate {
+			//-- the date is promoted to a timestamp, as casting the timestamp would drop its time
+			return sql + "::timestamptz", typeTimestamp
+		}
+		valid = isDate
+		cast = "::date"
+	case typeTimestamp:
+		valid = t == typeTimestamp || isTimeValue(value, timestampLayouts)
+		cast = "::timestamptz"
+	default:
+		return sqlFor(ctx), t
+	}
+	if !valid {
+		l.propertyError(ctx, property, "%s is not a valid %s for property %s", text, target, property)
+	}
+	return sql + cast, target
+}
+
+// formats of string values which can be cast to a date or timestamp
+var dateLayouts = []string{"2006-01-02"}
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"}
+
+func isTimeValue(value string, layouts []string) bool {
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, value); err == nil {
+			return true
+		}
+	}
+	return false
+}
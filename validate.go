@@ -0,0 +1,254 @@
+package cql2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// GeometryError reports an invalid geometry literal.
+// Line and Column give the position of the offending element in the CQL text
+// (line is 1-based, column is 0-based, as reported by the parser).
+type GeometryError struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *GeometryError) Error() string {
+	return fmt.Sprintf("CQL geometry error at line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+// WithGeometryValidation checks the structure of geometry literals before
+// SQL is generated: ring closure, minimum point counts, coordinate dimensions,
+// and coordinate ranges when the CRS of the literal is geographic.
+// maxVertices limits the number of vertices in a literal (0 means no limit).
+func WithGeometryValidation(maxVertices int) Option {
+	return func(l *cqlListener) {
+		l.validateGeom = true
+		l.maxVertices = maxVertices
+	}
+}
+
+func (l *cqlListener) geometryError(ctx antlr.ParserRuleContext, format string, args ...interface{}) {
+	start := ctx.GetStart()
+	l.setError(&GeometryError{
+		Line:   start.GetLine(),
+		Column: start.GetColumn(),
+		Msg:    fmt.Sprintf(format, args...),
+	})
+}
+
+func (l *cqlListener) ExitCoordinate(ctx *CoordinateContext) {
+	if !l.validateGeom {
+		return
+	}
+	ords, ok := ordinates(ctx)
+	if !ok {
+		l.geometryError(ctx, "coordinate %s is not a finite number", getText(ctx))
+		return
+	}
+	if l.isGeographicSRID(l.geomSRID) {
+		if ords[0] < -180 || ords[0] > 180 {
+			l.geometryError(ctx, "longitude %v is outside the range [-180,180]", ords[0])
+		} else if ords[1] < -90 || ords[1] > 90 {
+			l.geometryError(ctx, "latitude %v is outside the range [-90,90]", ords[1])
+		}
+	}
+}
+
+func (l *cqlListener) ExitLinestring(ctx *LinestringContext) {
+	if !l.validateGeom || ctx.CoordList() == nil {
+		return
+	}
+	l.validateLine(ctx, ctx.CoordList())
+}
+
+func (l *cqlListener) ExitMultiLinestring(ctx *MultiLinestringContext) {
+	if !l.validateGeom {
+		return
+	}
+	for _, line := range ctx.AllCoordList() {
+		l.validateLine(line, line)
+	}
+}
+
+func (l *cqlListener) ExitCompoundCurve(ctx *CompoundCurveContext) {
+	if !l.validateGeom {
+		return
+	}
+	//-- circular string members are checked on their own
+	for _, member := range ctx.AllCurveMember() {
+		if member.CoordList() != nil {
+			l.validateLine(member, member.CoordList())
+		}
+	}
+}
+
+func (l *cqlListener) ExitMultiCurve(ctx *MultiCurveContext) {
+	if !l.validateGeom {
+		return
+	}
+	for _, member := range ctx.AllCurveRing() {
+		if member.CoordList() != nil {
+			l.validateLine(member, member.CoordList())
+		}
+	}
+}
+
+// validateLine checks the points of a linestring, reporting errors at ctx
+func (l *cqlListener) validateLine(ctx antlr.ParserRuleContext, line ICoordListContext) {
+	if n := len(line.AllCoordinate()); n < 2 {
+		l.geometryError(ctx, "linestring has %d point(s), at least 2 are required", n)
+	}
+}
+
+func (l *cqlListener) ExitPolygonDef(ctx *PolygonDefContext) {
+	if !l.validateGeom {
+		return
+	}
+	for _, ring := range ctx.AllCoordList() {
+		l.validateRing(ring)
+	}
+}
+
+func (l *cqlListener) ExitCircularString(ctx *CircularStringContext) {
+	if !l.validateGeom || ctx.CoordList() == nil {
+		return
+	}
+	n := len(ctx.CoordList().AllCoordinate())
+	if n < 3 || n%2 == 0 {
+		l.geometryError(ctx, "circularstring has %d point(s), an odd number of at least 3 is required", n)
+	}
+}
+
+func (l *cqlListener) ExitCurvePolygon(ctx *CurvePolygonContext) {
+	if !l.validateGeom {
+		return
+	}
+	for _, ring := range ctx.AllCurveRing() {
+		if ring.CoordList() != nil {
+			l.validateRing(ring.CoordList())
+			continue
+		}
+		coords := coordinatesOf(ring)
+		if len(coords) > 0 && !sameCoordinate(coords[0], coords[len(coords)-1]) {
+			l.geometryError(ring, "ring is not closed")
+		}
+	}
+}
+
+// ExitEnvelope checks the order of the bounds of an envelope,
+// and their ranges when the CRS is geographic
+func (l *cqlListener) ExitEnvelope(ctx *EnvelopeContext) {
+	if !l.validateGeom {
+		return
+	}
+	var bounds []float64
+	for _, num := range ctx.AllNumericLiteral() {
+		v, err := strconv.ParseFloat(num.GetText(), 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			l.geometryError(ctx, "envelope bound %s is not a finite number", num.GetText())
+			return
+		}
+		bounds = append(bounds, v)
+	}
+	//-- west, south, [minimum elevation,] east, north[, maximum elevation]
+	half := len(bounds) / 2
+	west, south, east, north := bounds[0], bounds[1], bounds[half], bounds[half+1]
+	switch {
+	case south > north:
+		l.geometryError(ctx, "envelope south bound %v is greater than north bound %v", south, north)
+	case half == 3 && bounds[2] > bounds[5]:
+		l.geometryError(ctx, "envelope minimum elevation %v is greater than maximum %v", bounds[2], bounds[5])
+	case !l.isGeographicSRID(l.geomSRID):
+		//-- in a geographic CRS west > east denotes an envelope crossing the antimeridian
+		if west > east {
+			l.geometryError(ctx, "envelope west bound %v is greater than east bound %v", west, east)
+		}
+	case west < -180 || west > 180 || east < -180 || east > 180:
+		l.geometryError(ctx, "envelope longitudes %v and %v must be in the range [-180,180]", west, east)
+	case south < -90 || north > 90:
+		l.geometryError(ctx, "envelope latitudes %v and %v must be in the range [-90,90]", south, north)
+	}
+}
+
+func (l *cqlListener) validateRing(ring ICoordListContext) {
+	coords := ring.AllCoordinate()
+	if len(coords) < 4 {
+		l.geometryError(ring, "ring has %d point(s), at least 4 are required", len(coords))
+		return
+	}
+	if !sameCoordinate(coords[0], coords[len(coords)-1]) {
+		l.geometryError(ring, "ring is not closed")
+	}
+}
+
+// validateGeomLiteral checks the properties of a complete (top-level) literal
+func (l *cqlListener) validateGeomLiteral(ctx *GeomLiteralContext) {
+	coords := coordinatesOf(ctx)
+	if l.maxVertices > 0 && len(coords) > l.maxVertices {
+		l.geometryError(ctx, "geometry has %d vertices, the maximum is %d", len(coords), l.maxVertices)
+		return
+	}
+	dims := 0
+	if geom, ok := ctx.GetChild(0).(interface{ Dimension() IDimensionContext }); ok && geom.Dimension() != nil {
+		dims = 3
+		if strings.EqualFold(getText(geom.Dimension()), "ZM") {
+			dims = 4
+		}
+	}
+	for _, c := range coords {
+		n := len(c.AllNumericLiteral())
+		if dims == 0 {
+			dims = n
+		}
+		if n != dims {
+			l.geometryError(c, "coordinate has %d ordinates, expected %d", n, dims)
+			return
+		}
+	}
+}
+
+// coordinatesOf collects all coordinates in a parse subtree
+func coordinatesOf(tree antlr.Tree) []*CoordinateContext {
+	var coords []*CoordinateContext
+	for _, t := range tree.GetChildren() {
+		if c, ok := t.(*CoordinateContext); ok {
+			coords = append(coords, c)
+		} else {
+			coords = append(coords, coordinatesOf(t)...)
+		}
+	}
+	return coords
+}
+
+// ordinates returns the numeric values of a coordinate, and false if any is not finite
+func ordinates(ctx ICoordinateContext) ([]float64, bool) {
+	var ords []float64
+	for _, num := range ctx.AllNumericLiteral() {
+		v, err := strconv.ParseFloat(num.GetText(), 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, false
+		}
+		ords = append(ords, v)
+	}
+	return ords, true
+}
+
+func sameCoordinate(a ICoordinateContext, b ICoordinateContext) bool {
+	ordsA, okA := ordinates(a)
+	ordsB, okB := ordinates(b)
+	if !okA || !okB || len(ordsA) != len(ordsB) {
+		return false
+	}
+	for i := range ordsA {
+		if ordsA[i] != ordsB[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package cql2_test
+
+import (
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-geospatial/cql2-pgsql"
+)
+
+var _ = Describe("Geometry validation", func() {
+	DescribeTable("accepts valid geometries",
+		func(cqlStr string, sql string) {
+			actual, err := cql2.TranspileToSQL(cqlStr, 4326, 4326, cql2.WithGeometryValidation(10))
+			Expect(err).To(BeNil())
+			actual = strings.TrimSpace(actual)
+			Expect(actual).To(Equal(sql))
+		},
+		Entry("point", "intersects(geom, POINT(170 -45))",
+			"ST_Intersects(\"geom\",'SRID=4326;POINT(170 -45)'::geometry)"),
+		Entry("polygon", "intersects(geom, POLYGON((0 0, 0 9, 9 0, 0 0)))",
+			"ST_Intersects(\"geom\",'SRID=4326;POLYGON((0 0,0 9,9 0,0 0))'::geometry)"),
+		Entry("polygon Z", "intersects(geom, POLYGON Z((0 0 1, 0 9 1, 9 0 1, 0 0 1)))",
+			"ST_Intersects(\"geom\",'SRID=4326;POLYGON Z((0 0 1,0 9 1,9 0 1,0 0 1))'::geometry)"),
+		Entry("empty linestring", "intersects(geom, LINESTRING EMPTY)",
+			"ST_Intersects(\"geom\",'SRID=4326;LINESTRING EMPTY'::geometry)"),
+		Entry("envelope crossing the antimeridian", "intersects(geom, ENVELOPE(170, -10, -170, 10))",
+			"ST_Intersects(\"geom\",ST_Collect(ST_MakeEnvelope(170,-10,180,10,4326),ST_MakeEnvelope(-180,-10,-170,10,4326)))"),
+		Entry("curvepolygon", "intersects(geom, CURVEPOLYGON(CIRCULARSTRING(0 0, 4 0, 4 4, 0 4, 0 0)))",
+			"ST_Intersects(\"geom\",'SRID=4326;CURVEPOLYGON(CIRCULARSTRING(0 0,4 0,4 4,0 4,0 0))'::geometry)"),
+	)
+
+	DescribeTable("reports invalid geometries",
+		func(cqlStr string, srid int, column int, msg string) {
+			_, err := cql2.TranspileToSQL(cqlStr, srid, srid, cql2.WithGeometryValidation(10))
+			var geomErr *cql2.GeometryError
+			Expect(errors.As(err, &geomErr)).To(BeTrue())
+			Expect(geomErr.Line).To(Equal(1))
+			Expect(geomErr.Column).To(Equal(column))
+			Expect(geomErr.Msg).To(ContainSubstring(msg))
+		},
+		Entry("unclosed ring", "intersects(geom, POLYGON((0 0, 0 9, 9 0, 1 1)))", 4326, 25, "not closed"),
+		Entry("two point ring", "intersects(geom, POLYGON((0 0, 0 0)))", 4326, 25, "at least 4"),
+		Entry("single point linestring", "intersects(geom, LINESTRING(0 0))", 4326, 17, "at least 2"),
+		Entry("even circularstring", "intersects(geom, CIRCULARSTRING(0 0, 1 1))", 4326, 17, "odd number"),
+		Entry("longitude out of range", "intersects(geom, POINT(190 0))", 4326, 23, "longitude"),
+		Entry("latitude out of range", "intersects(geom, POINT(0 -91))", 4326, 23, "latitude"),
+		Entry("infinite coordinate", "intersects(geom, POINT(1e999 0))", 3857, 23, "finite"),
+		Entry("mixed dimensions", "intersects(geom, LINESTRING(0 0, 1 1 1))", 4326, 33, "ordinates"),
+		Entry("dimension mismatch", "intersects(geom, POINT Z(0 0))", 4326, 25, "expected 3"),
+		Entry("single point multilinestring member", "intersects(geom, MULTILINESTRING((0 0, 1 1), (0 0)))", 4326, 45, "at least 2"),
+		Entry("single point compoundcurve member", "intersects(geom, COMPOUNDCURVE(CIRCULARSTRING(0 0, 1 1, 1 0), (1 0)))", 4326, 62, "at least 2"),
+		Entry("single point multicurve member", "intersects(geom, MULTICURVE((0 0)))", 4326, 28, "at least 2"),
+		Entry("envelope south of north", "intersects(geom, ENVELOPE(0, 10, 5, 5))", 4326, 17, "south bound 10"),
+		Entry("envelope out of range", "intersects(geom, ENVELOPE(0, 0, 200, 5))", 4326, 17, "longitudes"),
+		Entry("envelope latitude out of range", "intersects(geom, ENVELOPE(0, -95, 10, 5))", 4326, 17, "latitudes"),
+		Entry("projected envelope west of east", "intersects(geom, ENVELOPE(10, 0, 5, 5))", 3857, 17, "west bound 10"),
+		Entry("envelope elevations", "intersects(geom, ENVELOPE(0, 0, 9, 5, 5, 1))", 4326, 17, "elevation 9"),
+		Entry("too many vertices", "intersects(geom, LINESTRING(0 0,1 1,2 2,3 3,4 4,5 5,6 6,7 7,8 8,9 9,10 10))", 4326, 17, "maximum is 10"),
+	)
+
+	It("is disabled by default", func() {
+		_, err := cql2.TranspileToSQL("intersects(geom, POLYGON((0 0, 0 9, 9 0, 1 1)))", 4326, 4326)
+		Expect(err).To(BeNil())
+	})
+
+	It("allows projected coordinates outside geographic ranges", func() {
+		_, err := cql2.TranspileToSQL("intersects(geom, POINT(500000 4500000))", 3857, 3857, cql2.WithGeometryValidation(0))
+		Expect(err).To(BeNil())
+	})
+})